@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"todo_list_go/pkg/auth"
 	"todo_list_go/pkg/hash"
 	"todo_list_go/pkg/logger"
+	"todo_list_go/pkg/storage"
 )
 
 // @title ToDO List API
@@ -64,17 +66,35 @@ func Run(configDir string) {
 
 	hasher := hash.NewSHA1Hasher()
 
+	fileStorage, err := newFileStorage(cfg.Attachments)
+	if err != nil {
+		logger.Errorf("failed to init attachments storage: %v", err.Error())
+		return
+	}
+
 	repositories := repository.NewRepositories(dbConn)
 	services := service.NewServices(
 		service.Deps{
-			Repos:          repositories,
-			AccessTokenTTL: cfg.Auth.JWT.AccessTokenTTL,
-			TokenManager:   tokenManager,
-			Hasher:         hasher,
+			Repos:                    repositories,
+			AccessTokenTTL:           cfg.Auth.JWT.AccessTokenTTL,
+			TokenManager:             tokenManager,
+			Hasher:                   hasher,
+			FileStorage:              fileStorage,
+			TaskDescriptionMaxLength: cfg.Tasks.DescriptionMaxLength,
+			UndoWindow:               cfg.Tasks.UndoWindow,
+			Attachments: service.AttachmentLimits{
+				MaxFileSize:  cfg.Attachments.MaxFileSize,
+				UserQuota:    cfg.Attachments.UserQuota,
+				ContentTypes: cfg.Attachments.ContentTypes,
+			},
 		},
 	)
 	handler := handlers.NewHandler(services, tokenManager)
 
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go runTrashPurge(purgeCtx, services.Trash, cfg.Trash)
+
 	srv := server.NewServer(cfg, handler.Init())
 
 	go func() {
@@ -100,3 +120,40 @@ func Run(configDir string) {
 		logger.Info("server stopped successfully")
 	}
 }
+
+func newFileStorage(cfg config.AttachmentsConfig) (storage.FileStorage, error) {
+	switch cfg.Storage {
+	case config.AttachmentsStorageLocal:
+		return storage.NewLocalStorage(cfg.LocalDir)
+	case config.AttachmentsStorageS3:
+		return storage.NewS3Storage(cfg.S3.Endpoint, cfg.S3.Region, cfg.S3.Bucket, cfg.S3.AccessKey, cfg.S3.SecretKey)
+	default:
+		return nil, fmt.Errorf("unknown attachments storage %q", cfg.Storage)
+	}
+}
+
+// runTrashPurge removes expired items from the trash every purge interval until ctx is canceled.
+func runTrashPurge(ctx context.Context, trash service.Trash, cfg config.TrashConfig) {
+	if cfg.PurgeInterval <= 0 {
+		logger.Info("trash purge is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(cfg.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := trash.Purge(ctx, cfg.Retention)
+		if err != nil {
+			logger.Errorf("failed to purge trash: %v", err)
+		} else if res.Tasks > 0 || res.Categories > 0 {
+			logger.Infof("trash purged: %d tasks, %d categories", res.Tasks, res.Categories)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
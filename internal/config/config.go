@@ -13,14 +13,38 @@ const (
 	defaultHTTPPort       = "8080"
 	defaultAccessTokenTTL = 7 * 24 * time.Hour // 1 week
 	defaultMigrationsPath = "file://migrations"
+	defaultTrashRetention = 30 * 24 * time.Hour // 30 days
+	defaultTrashPurge     = time.Hour
+
+	defaultTaskDescriptionMaxLength = 20000
+	defaultTaskUndoWindow           = 5 * time.Minute
+
+	defaultAttachmentsStorage     = AttachmentsStorageLocal
+	defaultAttachmentsLocalDir    = "uploads"
+	defaultAttachmentsMaxFileSize = 10 << 20  // 10 MiB
+	defaultAttachmentsUserQuota   = 100 << 20 // 100 MiB
+	defaultS3Region               = "us-east-1"
+)
+
+const (
+	AttachmentsStorageLocal = "local"
+	AttachmentsStorageS3    = "s3"
 )
 
+var defaultAttachmentsContentTypes = []string{
+	"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf", "text/plain", "application/zip",
+}
+
 type (
 	Config struct {
 		HTTP   HTTPConfig
 		Logger LoggerConfig
 		DB     DatabaseConfig
 		Auth   AuthConfig
+		Trash  TrashConfig
+		Tasks  TasksConfig
+		// Attachments are stored in the storage named by Storage, "local" or "s3"
+		Attachments AttachmentsConfig
 	}
 
 	HTTPConfig struct {
@@ -51,6 +75,39 @@ type (
 	AuthConfig struct {
 		JWT JWTConfig
 	}
+
+	// TrashConfig Retention is how long deleted tasks and categories are kept,
+	// PurgeInterval is how often expired ones are removed, purging is disabled when it isn't positive.
+	TrashConfig struct {
+		Retention     time.Duration `mapstructure:"retention"`
+		PurgeInterval time.Duration `mapstructure:"purgeInterval"`
+	}
+
+	// TasksConfig DescriptionMaxLength is the limit of task descriptions in characters,
+	// UndoWindow is how long updates and deletes of tasks can be undone.
+	TasksConfig struct {
+		DescriptionMaxLength int           `mapstructure:"descriptionMaxLength"`
+		UndoWindow           time.Duration `mapstructure:"undoWindow"`
+	}
+
+	// AttachmentsConfig MaxFileSize and UserQuota are in bytes, ContentTypes lists allowed media types.
+	AttachmentsConfig struct {
+		Storage      string   `mapstructure:"storage"`
+		LocalDir     string   `mapstructure:"localDir"`
+		MaxFileSize  int64    `mapstructure:"maxFileSize"`
+		UserQuota    int64    `mapstructure:"userQuota"`
+		ContentTypes []string `mapstructure:"contentTypes"`
+		S3           S3Config `mapstructure:"s3"`
+	}
+
+	// S3Config Endpoint is the base URL of an S3-compatible storage, e.g. http://localhost:9000.
+	S3Config struct {
+		Endpoint  string `mapstructure:"endpoint"`
+		Region    string `mapstructure:"region"`
+		Bucket    string `mapstructure:"bucket"`
+		AccessKey string
+		SecretKey string
+	}
 )
 
 func Init(configDir string) (*Config, error) {
@@ -90,6 +147,15 @@ func unmarshalConfig(cfg *Config) error {
 	if err := viper.UnmarshalKey("db", &cfg.DB); err != nil {
 		return err
 	}
+	if err := viper.UnmarshalKey("trash", &cfg.Trash); err != nil {
+		return err
+	}
+	if err := viper.UnmarshalKey("tasks", &cfg.Tasks); err != nil {
+		return err
+	}
+	if err := viper.UnmarshalKey("attachments", &cfg.Attachments); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,10 +186,23 @@ func setFormEnv(cfg *Config) {
 	cfg.DB.SSLMode = os.Getenv("DB_SSLMODE")
 
 	cfg.Auth.JWT.SigningKey = os.Getenv("SIGNING_KEY")
+
+	cfg.Attachments.S3.AccessKey = os.Getenv("S3_ACCESS_KEY")
+	cfg.Attachments.S3.SecretKey = os.Getenv("S3_SECRET_KEY")
 }
 
 func populateDefaults() {
 	viper.SetDefault("http_server.port", defaultHTTPPort)
 	viper.SetDefault("auth.accessTokenTTL", defaultAccessTokenTTL)
 	viper.SetDefault("db.migrationsPath", defaultMigrationsPath)
+	viper.SetDefault("trash.retention", defaultTrashRetention)
+	viper.SetDefault("trash.purgeInterval", defaultTrashPurge)
+	viper.SetDefault("tasks.descriptionMaxLength", defaultTaskDescriptionMaxLength)
+	viper.SetDefault("tasks.undoWindow", defaultTaskUndoWindow)
+	viper.SetDefault("attachments.storage", defaultAttachmentsStorage)
+	viper.SetDefault("attachments.localDir", defaultAttachmentsLocalDir)
+	viper.SetDefault("attachments.maxFileSize", defaultAttachmentsMaxFileSize)
+	viper.SetDefault("attachments.userQuota", defaultAttachmentsUserQuota)
+	viper.SetDefault("attachments.contentTypes", defaultAttachmentsContentTypes)
+	viper.SetDefault("attachments.s3.region", defaultS3Region)
 }
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type ActivityAction string
+
+const (
+	ActivityCreated   ActivityAction = "created"
+	ActivityUpdated   ActivityAction = "updated"
+	ActivityCompleted ActivityAction = "completed"
+	ActivityMoved     ActivityAction = "moved"
+	ActivityDeleted   ActivityAction = "deleted"
+	ActivityRestored  ActivityAction = "restored"
+	ActivityUndone    ActivityAction = "undone"
+)
+
+// ActivityFeedSort is the sort key of activity feed cursors, newest activities go first.
+const ActivityFeedSort = "-created_at"
+
+// Activity records a change of a task made by the user.
+type Activity struct {
+	ID        string    `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UserID    string    `json:"user_id" db:"user_id"`
+	TaskID    string    `json:"task_id" db:"task_id"`
+	// TaskTitle is the current title of the task
+	TaskTitle string         `json:"task_title" db:"task_title"`
+	Action    ActivityAction `json:"action" db:"action"`
+	Changes   FieldChanges   `json:"changes" db:"changes"`
+}
+
+// FieldChange holds values of a task field before and after the change, nil stands for an empty value.
+// Values are stored as JSON: strings, numbers and lists of strings.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// FieldChanges are stored in a JSONB column.
+type FieldChanges []FieldChange
+
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(c)
+}
+
+func (c *FieldChanges) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return errors.New("field changes must be scanned from bytes")
+	}
+	return json.Unmarshal(data, c)
+}
+
+// Fields returns names of the changed fields.
+func (c FieldChanges) Fields() []string {
+	fields := make([]string, len(c))
+	for i, change := range c {
+		fields[i] = change.Field
+	}
+	return fields
+}
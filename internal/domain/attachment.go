@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Attachment is a file attached to a task, its contents are kept in a file storage by StorageKey.
+type Attachment struct {
+	ID          string    `json:"id" db:"id"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	TaskID      string    `json:"task_id" db:"task_id"`
+	UserID      string    `json:"user_id" db:"user_id"`
+	FileName    string    `json:"file_name" db:"file_name"`
+	ContentType string    `json:"content_type" db:"content_type"`
+	Size        int64     `json:"size" db:"size"`
+	StorageKey  string    `json:"storage_key" db:"storage_key"`
+}
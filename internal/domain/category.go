@@ -9,4 +9,6 @@ type Category struct {
 	Title       string    `json:"title" db:"title"`
 	Description string    `json:"description" db:"description"`
 	Color       string    `json:"color" db:"color"`
+	// DeletedAt is set for categories in the trash
+	DeletedAt *time.Time `json:"deleted_at" db:"deleted_at"`
 }
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type ChecklistItem struct {
+	ID        string    `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	TaskID    string    `json:"task_id" db:"task_id"`
+	Title     string    `json:"title" db:"title"`
+	Completed bool      `json:"completed" db:"completed"`
+	Position  int       `json:"position" db:"position"`
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+type Comment struct {
+	ID        string    `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	TaskID    string    `json:"task_id" db:"task_id"`
+	// UserID is the author of the comment
+	UserID string `json:"user_id" db:"user_id"`
+	Body   string `json:"body" db:"body"`
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// positionDigits are base-62 digits in ascending byte order.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// positionIntegerLength is the length of the integer part of keys given out at the end of lists.
+const positionIntegerLength = 10
+
+// positionRebalanceLength is the key length after which positions of the list should be rewritten.
+const positionRebalanceLength = 32
+
+var ErrInvalidPositionRange = errors.New("position range is empty")
+
+// PositionBetween returns a fractional index key ordered between prev and next.
+// Keys are base-62 fractions compared byte by byte, an empty prev stands for the start of the list
+// and an empty next for its end. Keys never end with the smallest digit, so there is always
+// a key before any other key.
+func PositionBetween(prev, next string) (string, error) {
+	if !validPosition(prev) || !validPosition(next) || (next != "" && prev >= next) {
+		return "", ErrInvalidPositionRange
+	}
+	if next == "" {
+		return positionAfter(prev), nil
+	}
+
+	return positionMidpoint(prev, next), nil
+}
+
+// PositionNeedsRebalance reports whether the key got too long after repeated inserts at the same place.
+func PositionNeedsRebalance(position string) bool {
+	return len(position) > positionRebalanceLength
+}
+
+// positionAfter increments the integer part of the key, the first positionIntegerLength digits,
+// so keys appended to the end of a list keep the same length instead of growing.
+func positionAfter(last string) string {
+	integer := []byte(last[:min(len(last), positionIntegerLength)])
+	for len(integer) < positionIntegerLength {
+		integer = append(integer, positionDigits[0])
+	}
+
+	for i := len(integer) - 1; i >= 0; i-- {
+		digit := strings.IndexByte(positionDigits, integer[i])
+		if digit < len(positionDigits)-1 {
+			integer[i] = positionDigits[digit+1]
+			// the middle digit keeps the key from ending with the smallest digit
+			return string(integer) + positionDigits[len(positionDigits)/2:len(positionDigits)/2+1]
+		}
+		integer[i] = positionDigits[0]
+	}
+
+	// the integer part is exhausted
+	return positionMidpoint(last, "")
+}
+
+func validPosition(position string) bool {
+	for i := 0; i < len(position); i++ {
+		if strings.IndexByte(positionDigits, position[i]) < 0 {
+			return false
+		}
+	}
+	return !strings.HasSuffix(position, positionDigits[:1])
+}
+
+// positionMidpoint expects prev < next, prev is padded with the smallest digit when compared.
+func positionMidpoint(prev, next string) string {
+	if next != "" {
+		// keep the common prefix
+		n := 0
+		for n < len(next) && positionDigitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(prev) {
+				rest = prev[n:]
+			}
+			return next[:n] + positionMidpoint(rest, next[n:])
+		}
+	}
+
+	// the first digits differ
+	prevDigit := strings.IndexByte(positionDigits, positionDigitAt(prev, 0))
+	nextDigit := len(positionDigits)
+	if next != "" {
+		nextDigit = strings.IndexByte(positionDigits, next[0])
+	}
+	if nextDigit-prevDigit > 1 {
+		return positionDigits[(prevDigit+nextDigit)/2 : (prevDigit+nextDigit)/2+1]
+	}
+
+	// the first digits are consecutive
+	if len(next) > 1 {
+		return next[:1]
+	}
+	rest := ""
+	if len(prev) > 1 {
+		rest = prev[1:]
+	}
+	return positionDigits[prevDigit:prevDigit+1] + positionMidpoint(rest, "")
+}
+
+func positionDigitAt(position string, i int) byte {
+	if i < len(position) {
+		return position[i]
+	}
+	return positionDigits[0]
+}
@@ -1,16 +1,29 @@
 package domain
 
-import "strings"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
 
 const defaultPage = 1
 const defaultLimit = 20
 
+// PaginationQuery supports two modes: page/limit with OFFSET and keyset pagination by Cursor.
+// Cursor mode is enabled when the cursor param is present, an empty cursor requests the first page.
 type PaginationQuery struct {
-	Page   int `form:"page" binding:"omitempty"`
-	Limit  int `form:"limit" binding:"omitempty,max=50"`
+	Page   int     `form:"page" binding:"omitempty"`
+	Limit  int     `form:"limit" binding:"omitempty,max=50"`
+	Cursor *string `form:"cursor"`
 	Offset int
 }
 
+func (p *PaginationQuery) CursorMode() bool {
+	return p.Cursor != nil
+}
+
 func (p *PaginationQuery) NormalizePagination() {
 	if p.Page <= 0 {
 		p.Page = defaultPage
@@ -22,27 +35,199 @@ func (p *PaginationQuery) NormalizePagination() {
 	p.Offset = (p.Page - 1) * p.Limit
 }
 
+// TaskFiltersQuery created and updated date ranges include whole days of both bounds taken in Timezone, UTC by default.
 type TaskFiltersQuery struct {
-	CreatedAtDateFrom string   `form:"createdAtDateFrom"`
-	CreatedAtDateTo   string   `form:"createdAtDateTo"`
-	Completed         *bool    `form:"completed"`
-	CategoryIDs       []string `form:"categoryIds"`
+	CreatedAtDateFrom string `form:"createdAtDateFrom" binding:"omitempty,datetime=2006-01-02"`
+	CreatedAtDateTo   string `form:"createdAtDateTo" binding:"omitempty,datetime=2006-01-02"`
+	UpdatedAtDateFrom string `form:"updatedAtDateFrom" binding:"omitempty,datetime=2006-01-02"`
+	UpdatedAtDateTo   string `form:"updatedAtDateTo" binding:"omitempty,datetime=2006-01-02"`
+	Timezone          string `form:"timezone" binding:"omitempty,timezone"`
+	Completed         *bool  `form:"completed"`
+	// Archived selects archived tasks when true, archived tasks are excluded otherwise.
+	Archived    *bool    `form:"archived"`
+	CategoryIDs []string `form:"categoryIds"`
+	DueDateFrom string   `form:"dueDateFrom" binding:"omitempty,datetime=2006-01-02"`
+	DueDateTo   string   `form:"dueDateTo" binding:"omitempty,datetime=2006-01-02"`
+	Overdue     *bool    `form:"overdue"`
+	// Blocked selects tasks having open blockers when true and tasks without them when false.
+	Blocked    *bool    `form:"blocked"`
+	Priorities []string `form:"priorities" binding:"omitempty,dive,csv_oneof=none low medium high urgent"`
+	Statuses   []string `form:"status" binding:"omitempty,dive,csv_oneof=todo in_progress blocked done"`
+	// Q is a full-text search query over task titles and descriptions in web search syntax.
+	Q string `form:"q" binding:"omitempty,max=255"`
+	// TagIDs and Tags select tasks by tag ids and titles,
+	// TagMatch "any" (default) requires at least one of the tags and "all" requires every tag.
+	TagIDs   []string `form:"tagIds" binding:"omitempty,dive,csv_uuid"`
+	Tags     []string `form:"tags"`
+	TagMatch string   `form:"tagMatch" binding:"omitempty,oneof=any all"`
+	// Filter is an expression combining conditions with AND, OR and NOT, see ParseTaskFilter.
+	Filter string `form:"filter" binding:"omitempty,max=1000"`
+}
+
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+// Location returns the time zone dates of the filters are taken in.
+func (f *TaskFiltersQuery) Location() (*time.Location, error) {
+	if f.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(f.Timezone)
 }
 
 func (f *TaskFiltersQuery) NormalizeFilters() {
-	if len(f.CategoryIDs) == 1 {
-		raw := strings.Split(f.CategoryIDs[0], ",")
-		f.CategoryIDs = make([]string, 0, len(raw))
-		for i := range raw {
-			trimmed := strings.TrimSpace(raw[i])
-			if trimmed != "" {
-				f.CategoryIDs = append(f.CategoryIDs, trimmed)
-			}
+	f.CategoryIDs = splitCommaSeparated(f.CategoryIDs)
+	f.Priorities = splitCommaSeparated(f.Priorities)
+	f.Statuses = splitCommaSeparated(f.Statuses)
+	f.TagIDs = uniqueValues(splitCommaSeparated(f.TagIDs))
+	f.Tags = uniqueValues(splitCommaSeparated(f.Tags))
+}
+
+// splitCommaSeparated expands a single comma-separated query value into a list.
+func splitCommaSeparated(values []string) []string {
+	if len(values) != 1 {
+		return values
+	}
+
+	raw := strings.Split(values[0], ",")
+	result := make([]string, 0, len(raw))
+	for i := range raw {
+		trimmed := strings.TrimSpace(raw[i])
+		if trimmed != "" {
+			result = append(result, trimmed)
 		}
 	}
+	return result
+}
+
+func uniqueValues(values []string) []string {
+	if len(values) == 0 {
+		return values
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+const (
+	TaskSortCreatedAt     = "created_at"
+	TaskSortUpdatedAt     = "updated_at"
+	TaskSortTitle         = "title"
+	TaskSortPriority      = "priority"
+	TaskSortDueDate       = "due_date"
+	TaskSortCategoryTitle = "category_title"
+	TaskSortRelevance     = "relevance"
+	TaskSortPosition      = "position"
+
+	defaultTaskSort       = "-" + TaskSortCreatedAt
+	defaultTaskSearchSort = "-" + TaskSortRelevance
+)
+
+type SortField struct {
+	Name       string
+	Descending bool
+}
+
+// ParseSort parses a comma-separated list of field names,
+// fields prefixed with "-" are sorted in descending order.
+func ParseSort(raw string) []SortField {
+	fields := make([]SortField, 0)
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, descending := strings.CutPrefix(item, "-")
+		fields = append(fields, SortField{Name: name, Descending: descending})
+	}
+	return fields
 }
 
 type GetTasksQuery struct {
 	PaginationQuery
 	TaskFiltersQuery
+	Sort string `form:"sort" binding:"omitempty,sort_fields=created_at updated_at title priority due_date category_title relevance position"`
+	// Highlight adds search matches highlighted in titles and descriptions, used with Q.
+	Highlight bool `form:"highlight"`
+}
+
+// priorityTieBreakers order tasks of the same priority, sooner due dates and then newer tasks go first.
+var priorityTieBreakers = []SortField{{Name: TaskSortDueDate}, {Name: TaskSortCreatedAt, Descending: true}}
+
+// SortFields returns the requested sort order, newest tasks go first by default.
+// Priority sort puts the most important tasks first and breaks ties with priorityTieBreakers
+// unless the fields are sorted explicitly, -priority puts the least important tasks first.
+func (q *GetTasksQuery) SortFields() []SortField {
+	fields := ParseSort(q.SortKey())
+	i := slices.IndexFunc(fields, func(field SortField) bool { return field.Name == TaskSortPriority })
+	if i < 0 {
+		return fields
+	}
+
+	expanded := slices.Clone(fields[:i+1])
+	expanded[i].Descending = !expanded[i].Descending
+	for _, tieBreaker := range priorityTieBreakers {
+		if !slices.ContainsFunc(fields, func(field SortField) bool { return field.Name == tieBreaker.Name }) {
+			expanded = append(expanded, tieBreaker)
+		}
+	}
+	return append(expanded, fields[i+1:]...)
+}
+
+// SortKey returns the sort expression the list is ordered by,
+// search results are ordered by relevance unless another sort is requested.
+func (q *GetTasksQuery) SortKey() string {
+	if q.Sort != "" {
+		return q.Sort
+	}
+	if q.Q != "" {
+		return defaultTaskSearchSort
+	}
+	return defaultTaskSort
+}
+
+// SortsBy reports whether the list is ordered by the field.
+func (q *GetTasksQuery) SortsBy(name string) bool {
+	for _, field := range q.SortFields() {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Cursor points to the last item of a page. Values hold the item values of the sort fields
+// in the order of Sort, nil value stands for NULL.
+type Cursor struct {
+	Sort   string    `json:"s"`
+	Values []*string `json:"v"`
+	ID     string    `json:"id"`
+}
+
+// Encode returns an opaque representation of the cursor that is safe to use in URLs.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(raw string) (Cursor, error) {
+	var cursor Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return Cursor{}, err
+	}
+
+	return cursor, nil
 }
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QuickAddTask holds the fields parsed from a quick-add line like "Pay rent tomorrow 9am #Finance !high @bills".
+// Category and Tags are titles, DueDate is YYYY-MM-DD and DueTime is HH:MM, empty fields weren't given.
+type QuickAddTask struct {
+	Title    string   `json:"title"`
+	Category string   `json:"category"`
+	Priority string   `json:"priority"`
+	DueDate  string   `json:"due_date"`
+	DueTime  string   `json:"due_time"`
+	Tags     []string `json:"tags"`
+}
+
+var (
+	quickAddTime12Pattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+	quickAddTime24Pattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	quickAddWeekdays      = map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday,
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	}
+)
+
+// ParseQuickAdd extracts task fields from the line, relative dates are taken from now in its location.
+//
+// Recognized markers:
+//   - #category and @tag, tags may be repeated
+//   - !priority with one of the task priority names
+//   - dates: today, tomorrow, a weekday name (the next such day after today), next week (next Monday),
+//     in N days/weeks and YYYY-MM-DD, optionally preceded by "on"
+//   - times: 9am, 9:30pm, 21:00, noon and midnight, optionally preceded by "at"; a time without a date is due today
+//
+// The first category, priority, date and time are used, repeated ones and everything else make up the title.
+func ParseQuickAdd(text string, now time.Time) QuickAddTask {
+	var task QuickAddTask
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	words := strings.Fields(text)
+	title := make([]string, 0, len(words))
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		lower := strings.ToLower(word)
+
+		switch {
+		case strings.HasPrefix(word, "#") && len(word) > 1 && task.Category == "":
+			task.Category = word[1:]
+			continue
+		case strings.HasPrefix(word, "@") && len(word) > 1:
+			if !containsFold(task.Tags, word[1:]) {
+				task.Tags = append(task.Tags, word[1:])
+			}
+			continue
+		case strings.HasPrefix(word, "!") && task.Priority == "":
+			if _, err := ParseTaskPriority(lower[1:]); err == nil && len(lower) > 1 {
+				task.Priority = lower[1:]
+				continue
+			}
+		}
+
+		if task.DueDate == "" {
+			// "on" is a part of the date only when a date follows
+			start := i
+			if lower == "on" && i+1 < len(words) {
+				start = i + 1
+			}
+			if date, n, ok := parseQuickAddDate(words[start:], today); ok {
+				task.DueDate = date.Format(time.DateOnly)
+				i = start + n - 1
+				continue
+			}
+		}
+
+		if task.DueTime == "" {
+			start := i
+			if lower == "at" && i+1 < len(words) {
+				start = i + 1
+			}
+			if clock, ok := parseQuickAddTime(words[start]); ok {
+				task.DueTime = clock
+				i = start
+				continue
+			}
+		}
+
+		title = append(title, word)
+	}
+
+	if task.DueTime != "" && task.DueDate == "" {
+		task.DueDate = today.Format(time.DateOnly)
+	}
+	task.Title = strings.Join(title, " ")
+
+	return task
+}
+
+// parseQuickAddDate parses the date at the start of words and returns the number of words it takes.
+func parseQuickAddDate(words []string, today time.Time) (time.Time, int, bool) {
+	if len(words) == 0 {
+		return time.Time{}, 0, false
+	}
+	first := strings.ToLower(words[0])
+
+	switch first {
+	case "today":
+		return today, 1, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), 1, true
+	}
+
+	if weekday, ok := quickAddWeekdays[first]; ok {
+		days := (int(weekday)-int(today.Weekday())+6)%7 + 1
+		return today.AddDate(0, 0, days), 1, true
+	}
+
+	if first == "next" && len(words) > 1 && strings.ToLower(words[1]) == "week" {
+		days := (int(time.Monday)-int(today.Weekday())+6)%7 + 1
+		return today.AddDate(0, 0, days), 2, true
+	}
+
+	if first == "in" && len(words) > 2 {
+		n, err := strconv.Atoi(words[1])
+		if err == nil && n >= 0 {
+			switch strings.ToLower(words[2]) {
+			case "day", "days":
+				return today.AddDate(0, 0, n), 3, true
+			case "week", "weeks":
+				return today.AddDate(0, 0, 7*n), 3, true
+			}
+		}
+	}
+
+	if date, err := time.Parse(time.DateOnly, first); err == nil {
+		return date, 1, true
+	}
+
+	return time.Time{}, 0, false
+}
+
+// parseQuickAddTime returns the time of the word formatted as HH:MM.
+func parseQuickAddTime(word string) (string, bool) {
+	lower := strings.ToLower(word)
+
+	switch lower {
+	case "noon":
+		return "12:00", true
+	case "midnight":
+		return "00:00", true
+	}
+
+	if match := quickAddTime12Pattern.FindStringSubmatch(lower); match != nil {
+		hour, _ := strconv.Atoi(match[1])
+		minute := 0
+		if match[2] != "" {
+			minute, _ = strconv.Atoi(match[2])
+		}
+		if hour < 1 || hour > 12 || minute > 59 {
+			return "", false
+		}
+		hour %= 12
+		if match[3] == "pm" {
+			hour += 12
+		}
+		return formatQuickAddTime(hour, minute), true
+	}
+
+	if match := quickAddTime24Pattern.FindStringSubmatch(lower); match != nil {
+		hour, _ := strconv.Atoi(match[1])
+		minute, _ := strconv.Atoi(match[2])
+		if hour > 23 || minute > 59 {
+			return "", false
+		}
+		return formatQuickAddTime(hour, minute), true
+	}
+
+	return "", false
+}
+
+func formatQuickAddTime(hour, minute int) string {
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC).Format("15:04")
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
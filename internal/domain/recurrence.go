@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "daily"
+	RecurrenceWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+	// RecurrenceAfterCompletion repeats a task Interval days after it was completed
+	RecurrenceAfterCompletion RecurrenceFrequency = "after_completion"
+)
+
+// maxRecurrenceInterval limits intervals, so the search of the next occurrence stays short.
+const maxRecurrenceInterval = 365
+
+var weekdayNames = map[time.Weekday]string{
+	time.Monday:    "mo",
+	time.Tuesday:   "tu",
+	time.Wednesday: "we",
+	time.Thursday:  "th",
+	time.Friday:    "fr",
+	time.Saturday:  "sa",
+	time.Sunday:    "su",
+}
+
+// Recurrence is a schedule of a recurring task. Weekdays are used only with the weekly frequency
+// and MonthDay only with the monthly one, months shorter than MonthDay use their last day.
+type Recurrence struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	Weekdays  []time.Weekday
+	MonthDay  int
+}
+
+// NewRecurrence builds Recurrence from API values, weekdays are two-letter names (mo, tu, ...).
+// Zero interval means 1.
+func NewRecurrence(frequency string, interval int, weekdays []string, monthDay int) (Recurrence, error) {
+	if interval == 0 {
+		interval = 1
+	}
+
+	recurrence := Recurrence{Frequency: RecurrenceFrequency(frequency), Interval: interval, MonthDay: monthDay}
+	for _, name := range weekdays {
+		weekday, err := parseWeekday(name)
+		if err != nil {
+			return Recurrence{}, err
+		}
+		if !slices.Contains(recurrence.Weekdays, weekday) {
+			recurrence.Weekdays = append(recurrence.Weekdays, weekday)
+		}
+	}
+	slices.Sort(recurrence.Weekdays)
+
+	if err := recurrence.validate(); err != nil {
+		return Recurrence{}, err
+	}
+
+	return recurrence, nil
+}
+
+func (r Recurrence) validate() error {
+	if r.Interval < 1 || r.Interval > maxRecurrenceInterval {
+		return fmt.Errorf("recurrence interval must be between 1 and %d", maxRecurrenceInterval)
+	}
+
+	switch r.Frequency {
+	case RecurrenceDaily, RecurrenceAfterCompletion:
+		if len(r.Weekdays) > 0 || r.MonthDay != 0 {
+			return fmt.Errorf("%s recurrence doesn't accept weekdays or month day", r.Frequency)
+		}
+	case RecurrenceWeekly:
+		if len(r.Weekdays) == 0 || r.MonthDay != 0 {
+			return fmt.Errorf("weekly recurrence requires weekdays only")
+		}
+	case RecurrenceMonthly:
+		if r.MonthDay < 1 || r.MonthDay > 31 || len(r.Weekdays) > 0 {
+			return fmt.Errorf("monthly recurrence requires a month day between 1 and 31 only")
+		}
+	default:
+		return fmt.Errorf("unknown recurrence frequency: %s", r.Frequency)
+	}
+
+	return nil
+}
+
+// WeekdayNames returns two-letter names of Weekdays.
+func (r Recurrence) WeekdayNames() []string {
+	names := make([]string, len(r.Weekdays))
+	for i, weekday := range r.Weekdays {
+		names[i] = weekdayNames[weekday]
+	}
+	return names
+}
+
+// String encodes the recurrence as an RRULE-like rule, e.g. FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR.
+// Recurrence after completion is encoded as FREQ=DAILY with FROM=COMPLETION.
+func (r Recurrence) String() string {
+	parts := make([]string, 0, 3)
+	switch r.Frequency {
+	case RecurrenceDaily, RecurrenceAfterCompletion:
+		parts = append(parts, "FREQ=DAILY")
+	case RecurrenceWeekly:
+		parts = append(parts, "FREQ=WEEKLY")
+	case RecurrenceMonthly:
+		parts = append(parts, "FREQ=MONTHLY")
+	}
+	parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+
+	switch r.Frequency {
+	case RecurrenceWeekly:
+		parts = append(parts, "BYDAY="+strings.ToUpper(strings.Join(r.WeekdayNames(), ",")))
+	case RecurrenceMonthly:
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	case RecurrenceAfterCompletion:
+		parts = append(parts, "FROM=COMPLETION")
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// ParseRecurrence decodes a rule produced by Recurrence.String.
+func ParseRecurrence(rule string) (Recurrence, error) {
+	var frequency string
+	var interval, monthDay int
+	var weekdays []string
+	fromCompletion := false
+
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule part: %s", part)
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			frequency = strings.ToLower(value)
+		case "INTERVAL":
+			interval, err = strconv.Atoi(value)
+		case "BYDAY":
+			weekdays = strings.Split(strings.ToLower(value), ",")
+		case "BYMONTHDAY":
+			monthDay, err = strconv.Atoi(value)
+		case "FROM":
+			fromCompletion = value == "COMPLETION"
+		default:
+			err = fmt.Errorf("unknown recurrence rule part: %s", key)
+		}
+		if err != nil {
+			return Recurrence{}, err
+		}
+	}
+
+	if fromCompletion {
+		if frequency != string(RecurrenceDaily) {
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule: %s", rule)
+		}
+		frequency = string(RecurrenceAfterCompletion)
+	}
+
+	return NewRecurrence(frequency, interval, weekdays, monthDay)
+}
+
+// Next returns the first date of the schedule after the given date.
+// Dates are calendar days, their time of day and location are ignored.
+// For recurrence after completion the given date is the completion date.
+func (r Recurrence) Next(after time.Time) time.Time {
+	after = time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch r.Frequency {
+	case RecurrenceWeekly:
+		return r.nextWeekly(after)
+	case RecurrenceMonthly:
+		return r.nextMonthly(after)
+	default:
+		return after.AddDate(0, 0, r.Interval)
+	}
+}
+
+// nextWeekly counts weeks from the week of the given date, weeks start on Monday.
+func (r Recurrence) nextWeekly(after time.Time) time.Time {
+	weekStart := startOfWeek(after)
+	for day := after.AddDate(0, 0, 1); ; day = day.AddDate(0, 0, 1) {
+		week := int(startOfWeek(day).Sub(weekStart).Hours()) / (24 * 7)
+		if week%r.Interval == 0 && slices.Contains(r.Weekdays, day.Weekday()) {
+			return day
+		}
+	}
+}
+
+// nextMonthly counts months from the month of the given date.
+func (r Recurrence) nextMonthly(after time.Time) time.Time {
+	for months := 0; ; months += r.Interval {
+		firstDay := time.Date(after.Year(), after.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+		lastDay := firstDay.AddDate(0, 1, -1).Day()
+		day := time.Date(firstDay.Year(), firstDay.Month(), min(r.MonthDay, lastDay), 0, 0, 0, 0, time.UTC)
+		if day.After(after) {
+			return day
+		}
+	}
+}
+
+func startOfWeek(day time.Time) time.Time {
+	daysSinceMonday := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -daysSinceMonday)
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	for weekday, weekdayName := range weekdayNames {
+		if weekdayName == name {
+			return weekday, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown weekday: %s", name)
+}
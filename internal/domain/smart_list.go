@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SmartList is a named task list query stored by the user.
+type SmartList struct {
+	ID        string         `json:"id" db:"id"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at" db:"updated_at"`
+	UserID    string         `json:"user_id" db:"user_id"`
+	Title     string         `json:"title" db:"title"`
+	Query     SmartListQuery `json:"query" db:"query"`
+}
+
+// SmartListQuery holds the filters and sort of a task list query, see TaskFiltersQuery.
+// Relative dates are resolved and whole days are matched in Timezone, UTC by default, when the list is run.
+type SmartListQuery struct {
+	Completed   *bool     `json:"completed"`
+	Archived    *bool     `json:"archived"`
+	CategoryIDs []string  `json:"category_ids"`
+	Overdue     *bool     `json:"overdue"`
+	Blocked     *bool     `json:"blocked"`
+	Priorities  []string  `json:"priorities"`
+	Statuses    []string  `json:"statuses"`
+	TagIDs      []string  `json:"tag_ids"`
+	Tags        []string  `json:"tags"`
+	TagMatch    string    `json:"tag_match"`
+	Q           string    `json:"q"`
+	Filter      string    `json:"filter"`
+	CreatedAt   DateRange `json:"created_at"`
+	UpdatedAt   DateRange `json:"updated_at"`
+	DueDate     DateRange `json:"due_date"`
+	Timezone    string    `json:"timezone"`
+	Sort        string    `json:"sort"`
+}
+
+// DateRange is either a Relative range or inclusive From and To bounds, each bound is optional.
+// Relative is one of: today, yesterday, tomorrow, this/last/next week, this/last/next month,
+// last N days (ending today) and next N days (starting today), weeks start on Monday.
+// Bounds are YYYY-MM-DD dates or one of: today, yesterday, tomorrow.
+type DateRange struct {
+	Relative string `json:"relative,omitempty"`
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+}
+
+func (q SmartListQuery) Value() (driver.Value, error) {
+	return json.Marshal(q)
+}
+
+func (q *SmartListQuery) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return errors.New("smart list query must be scanned from bytes")
+	}
+	return json.Unmarshal(data, q)
+}
+
+// TasksQuery builds the task list query with relative dates resolved at now.
+func (q SmartListQuery) TasksQuery(now time.Time) (GetTasksQuery, error) {
+	location := time.UTC
+	if q.Timezone != "" {
+		var err error
+		location, err = time.LoadLocation(q.Timezone)
+		if err != nil {
+			return GetTasksQuery{}, fmt.Errorf("timezone: %w", err)
+		}
+	}
+	localNow := now.In(location)
+	today := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, time.UTC)
+
+	createdFrom, createdTo, err := q.CreatedAt.Resolve(today)
+	if err != nil {
+		return GetTasksQuery{}, fmt.Errorf("created_at: %w", err)
+	}
+	updatedFrom, updatedTo, err := q.UpdatedAt.Resolve(today)
+	if err != nil {
+		return GetTasksQuery{}, fmt.Errorf("updated_at: %w", err)
+	}
+	dueFrom, dueTo, err := q.DueDate.Resolve(today)
+	if err != nil {
+		return GetTasksQuery{}, fmt.Errorf("due_date: %w", err)
+	}
+
+	query := GetTasksQuery{
+		TaskFiltersQuery: TaskFiltersQuery{
+			CreatedAtDateFrom: createdFrom,
+			CreatedAtDateTo:   createdTo,
+			UpdatedAtDateFrom: updatedFrom,
+			UpdatedAtDateTo:   updatedTo,
+			Timezone:          q.Timezone,
+			Completed:         q.Completed,
+			Archived:          q.Archived,
+			CategoryIDs:       q.CategoryIDs,
+			DueDateFrom:       dueFrom,
+			DueDateTo:         dueTo,
+			Overdue:           q.Overdue,
+			Blocked:           q.Blocked,
+			Priorities:        q.Priorities,
+			Statuses:          q.Statuses,
+			Q:                 q.Q,
+			TagIDs:            q.TagIDs,
+			Tags:              q.Tags,
+			TagMatch:          q.TagMatch,
+			Filter:            q.Filter,
+		},
+		Sort: q.Sort,
+	}
+	query.NormalizeFilters()
+
+	return query, nil
+}
+
+// Resolve returns the YYYY-MM-DD bounds of the range for the given day, empty bounds are open.
+func (r DateRange) Resolve(today time.Time) (string, string, error) {
+	if r.Relative != "" {
+		if r.From != "" || r.To != "" {
+			return "", "", errors.New("relative range can't be combined with from and to")
+		}
+		from, to, err := relativeDateRange(strings.ToLower(strings.TrimSpace(r.Relative)), today)
+		if err != nil {
+			return "", "", err
+		}
+		return from.Format(time.DateOnly), to.Format(time.DateOnly), nil
+	}
+
+	from, err := resolveDateBound(r.From, today)
+	if err != nil {
+		return "", "", err
+	}
+	to, err := resolveDateBound(r.To, today)
+	if err != nil {
+		return "", "", err
+	}
+	return from, to, nil
+}
+
+func relativeDateRange(value string, today time.Time) (time.Time, time.Time, error) {
+	weekStart := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	switch value {
+	case "today":
+		return today, today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), today.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), today.AddDate(0, 0, 1), nil
+	case "this week":
+		return weekStart, weekStart.AddDate(0, 0, 6), nil
+	case "last week":
+		return weekStart.AddDate(0, 0, -7), weekStart.AddDate(0, 0, -1), nil
+	case "next week":
+		return weekStart.AddDate(0, 0, 7), weekStart.AddDate(0, 0, 13), nil
+	case "this month":
+		return monthStart, monthStart.AddDate(0, 1, -1), nil
+	case "last month":
+		return monthStart.AddDate(0, -1, 0), monthStart.AddDate(0, 0, -1), nil
+	case "next month":
+		return monthStart.AddDate(0, 1, 0), monthStart.AddDate(0, 2, -1), nil
+	}
+
+	words := strings.Fields(value)
+	if len(words) == 3 && (words[2] == "days" || words[2] == "day") {
+		n, err := strconv.Atoi(words[1])
+		if err == nil && n > 0 {
+			switch words[0] {
+			case "last":
+				return today.AddDate(0, 0, 1-n), today, nil
+			case "next":
+				return today, today.AddDate(0, 0, n-1), nil
+			}
+		}
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("unknown relative date range %q", value)
+}
+
+func resolveDateBound(value string, today time.Time) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return "", nil
+	case "today":
+		return today.Format(time.DateOnly), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1).Format(time.DateOnly), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1).Format(time.DateOnly), nil
+	}
+
+	if _, err := time.Parse(time.DateOnly, value); err != nil {
+		return "", fmt.Errorf("invalid date %q", value)
+	}
+	return value, nil
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+type Tag struct {
+	ID        string    `json:"id" db:"id"`
+	UserID    string    `json:"user_id" db:"user_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	Title     string    `json:"title" db:"title"`
+	Color     string    `json:"color" db:"color"`
+}
@@ -1,6 +1,12 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const DueTimeLayout = "15:04"
 
 type Task struct {
 	ID          string    `json:"id" db:"id"`
@@ -10,5 +16,161 @@ type Task struct {
 	CategoryID  string    `json:"category_id" db:"category_id"`
 	Title       string    `json:"title" db:"title"`
 	Description string    `json:"description" db:"description"`
-	Completed   bool      `json:"completed" db:"completed"`
+	// Completed is derived from Status, it is set for done tasks
+	Completed   bool         `json:"completed" db:"completed"`
+	Status      TaskStatus   `json:"status" db:"status"`
+	Priority    TaskPriority `json:"priority" db:"priority"`
+	DueDate     *time.Time   `json:"due_date" db:"due_date"`
+	DueTime     *string      `json:"due_time" db:"due_time"`
+	DueTimezone *string      `json:"due_timezone" db:"due_timezone"`
+	DueAt       *time.Time   `json:"due_at" db:"due_at"`
+	// EstimateMinutes is the expected time to finish the task, nil when it isn't estimated
+	EstimateMinutes *int `json:"estimate_minutes" db:"estimate_minutes"`
+	// RecurrenceRule is a Recurrence encoded with Recurrence.String, it is carried by the latest occurrence.
+	// SeriesID is shared by all occurrences of a recurring task.
+	RecurrenceRule *string `json:"recurrence_rule" db:"recurrence_rule"`
+	SeriesID       *string `json:"series_id" db:"series_id"`
+	// Position orders tasks within a category, see PositionBetween
+	Position string `json:"position" db:"position"`
+	// ArchivedAt is set for archived tasks, they are hidden from the task list by default
+	ArchivedAt *time.Time `json:"archived_at" db:"archived_at"`
+	// DeletedAt is set for tasks in the trash
+	DeletedAt *time.Time `json:"deleted_at" db:"deleted_at"`
+}
+
+// TaskDue describes when a task is due. Time and Timezone are optional,
+// a task without a due time is due by the end of its due date.
+// At is the resulting moment, it is used for sorting and overdue checks.
+type TaskDue struct {
+	Date     *time.Time
+	Time     *string
+	Timezone *string
+	At       *time.Time
+}
+
+// NewTaskDue builds TaskDue from a date in yyyy-mm-dd format, an optional time
+// in hh:mm format and an optional IANA time zone name. Empty date means no due date.
+func NewTaskDue(date, clock, timezone string) (TaskDue, error) {
+	if date == "" {
+		return TaskDue{}, nil
+	}
+
+	dueDate, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return TaskDue{}, err
+	}
+
+	location := time.UTC
+	if timezone != "" {
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			return TaskDue{}, err
+		}
+	}
+
+	hour, minute, second := 23, 59, 59
+	if clock != "" {
+		dueTime, err := time.Parse(DueTimeLayout, clock)
+		if err != nil {
+			return TaskDue{}, err
+		}
+		hour, minute, second = dueTime.Hour(), dueTime.Minute(), 0
+	}
+
+	dueAt := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), hour, minute, second, 0, location)
+	due := TaskDue{Date: &dueDate, At: &dueAt}
+	if clock != "" {
+		due.Time = &clock
+	}
+	if timezone != "" {
+		due.Timezone = &timezone
+	}
+
+	return due, nil
+}
+
+type TaskPriority int16
+
+const (
+	TaskPriorityNone TaskPriority = iota
+	TaskPriorityLow
+	TaskPriorityMedium
+	TaskPriorityHigh
+	TaskPriorityUrgent
+)
+
+var taskPriorityNames = map[TaskPriority]string{
+	TaskPriorityNone:   "none",
+	TaskPriorityLow:    "low",
+	TaskPriorityMedium: "medium",
+	TaskPriorityHigh:   "high",
+	TaskPriorityUrgent: "urgent",
+}
+
+func (p TaskPriority) String() string {
+	return taskPriorityNames[p]
+}
+
+// ParseTaskPriority converts a priority name to TaskPriority, empty name means no priority.
+func ParseTaskPriority(name string) (TaskPriority, error) {
+	if name == "" {
+		return TaskPriorityNone, nil
+	}
+
+	for priority, priorityName := range taskPriorityNames {
+		if priorityName == name {
+			return priority, nil
+		}
+	}
+
+	return TaskPriorityNone, fmt.Errorf("unknown task priority: %s", name)
+}
+
+type TaskStatus int16
+
+const (
+	TaskStatusTodo TaskStatus = iota
+	TaskStatusInProgress
+	TaskStatusBlocked
+	TaskStatusDone
+)
+
+var taskStatusNames = map[TaskStatus]string{
+	TaskStatusTodo:       "todo",
+	TaskStatusInProgress: "in_progress",
+	TaskStatusBlocked:    "blocked",
+	TaskStatusDone:       "done",
+}
+
+// taskStatusTransitions lists statuses a task can move to from each status,
+// a blocked task has to be unblocked before it is done.
+var taskStatusTransitions = map[TaskStatus][]TaskStatus{
+	TaskStatusTodo:       {TaskStatusInProgress, TaskStatusBlocked, TaskStatusDone},
+	TaskStatusInProgress: {TaskStatusTodo, TaskStatusBlocked, TaskStatusDone},
+	TaskStatusBlocked:    {TaskStatusTodo, TaskStatusInProgress},
+	TaskStatusDone:       {TaskStatusTodo, TaskStatusInProgress},
+}
+
+func (s TaskStatus) String() string {
+	return taskStatusNames[s]
+}
+
+// CanTransitionTo reports whether the status can be changed to another one, keeping the status is always allowed.
+func (s TaskStatus) CanTransitionTo(status TaskStatus) bool {
+	return s == status || slices.Contains(taskStatusTransitions[s], status)
+}
+
+// ParseTaskStatus converts a status name to TaskStatus, empty name means todo.
+func ParseTaskStatus(name string) (TaskStatus, error) {
+	if name == "" {
+		return TaskStatusTodo, nil
+	}
+
+	for status, statusName := range taskStatusNames {
+		if statusName == name {
+			return status, nil
+		}
+	}
+
+	return TaskStatusTodo, fmt.Errorf("unknown task status: %s", name)
 }
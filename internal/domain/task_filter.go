@@ -0,0 +1,408 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// TaskFilter is a node of a parsed task filter expression:
+// TaskFilterAnd, TaskFilterOr, TaskFilterNot or TaskFilterCondition.
+type TaskFilter interface {
+	taskFilter()
+}
+
+type TaskFilterAnd struct {
+	Left, Right TaskFilter
+}
+
+type TaskFilterOr struct {
+	Left, Right TaskFilter
+}
+
+type TaskFilterNot struct {
+	Operand TaskFilter
+}
+
+// TaskFilterCondition compares a task field with a value.
+// Values are normalized: priorities and statuses are lowercase names, booleans are "true" or "false"
+// and dates are YYYY-MM-DD.
+type TaskFilterCondition struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+func (TaskFilterAnd) taskFilter()       {}
+func (TaskFilterOr) taskFilter()        {}
+func (TaskFilterNot) taskFilter()       {}
+func (TaskFilterCondition) taskFilter() {}
+
+const (
+	TaskFilterEqual        = "="
+	TaskFilterNotEqual     = "!="
+	TaskFilterLess         = "<"
+	TaskFilterLessEqual    = "<="
+	TaskFilterGreater      = ">"
+	TaskFilterGreaterEqual = ">="
+	TaskFilterContains     = "~"
+)
+
+const (
+	TaskFilterCategory  = "category"
+	TaskFilterTag       = "tag"
+	TaskFilterTitle     = "title"
+	TaskFilterStatus    = "status"
+	TaskFilterPriority  = "priority"
+	TaskFilterCompleted = "completed"
+	TaskFilterOverdue   = "overdue"
+	TaskFilterBlocked   = "blocked"
+	TaskFilterCreated   = "created"
+	TaskFilterUpdated   = "updated"
+	TaskFilterDue       = "due"
+)
+
+type taskFilterValueKind int
+
+const (
+	taskFilterText taskFilterValueKind = iota
+	taskFilterBool
+	taskFilterDate
+	taskFilterStatus
+	taskFilterPriority
+)
+
+var taskFilterFields = map[string]taskFilterValueKind{
+	TaskFilterCategory:  taskFilterText,
+	TaskFilterTag:       taskFilterText,
+	TaskFilterTitle:     taskFilterText,
+	TaskFilterStatus:    taskFilterStatus,
+	TaskFilterPriority:  taskFilterPriority,
+	TaskFilterCompleted: taskFilterBool,
+	TaskFilterOverdue:   taskFilterBool,
+	TaskFilterBlocked:   taskFilterBool,
+	TaskFilterCreated:   taskFilterDate,
+	TaskFilterUpdated:   taskFilterDate,
+	TaskFilterDue:       taskFilterDate,
+}
+
+// TaskFilterError is a syntax error of a filter expression,
+// Position is the 1-based number of the character where the problem is found.
+type TaskFilterError struct {
+	Position int
+	Message  string
+}
+
+func (e *TaskFilterError) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Position, e.Message)
+}
+
+// ParseTaskFilter parses a filter expression like
+// `(category:Work OR category:Home) AND NOT completed AND created>2025-01-01`.
+//
+// Conditions are field:value, field=value, field!=value or comparisons with <, <=, > and >=:
+//   - category and tag compare titles case-insensitively, ":" and "=" mean equal
+//   - title:text matches titles containing the text
+//   - status and priority take names, priorities can be compared by their order
+//   - created, updated and due take YYYY-MM-DD dates, "=" matches the whole day
+//   - completed, overdue and blocked may be used alone or with true and false
+//
+// Conditions are combined with NOT, AND and OR in that precedence, AND may be omitted,
+// keywords are case-insensitive and values with spaces are put in double quotes.
+func ParseTaskFilter(expr string) (TaskFilter, error) {
+	tokens, err := lexTaskFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &taskFilterParser{tokens: tokens}
+	if p.peek().kind == filterTokenEOF {
+		return nil, &TaskFilterError{Position: p.peek().pos, Message: "filter is empty"}
+	}
+
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != filterTokenEOF {
+		return nil, &TaskFilterError{Position: token.pos, Message: fmt.Sprintf("unexpected %s", token)}
+	}
+
+	return filter, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOperator
+	filterTokenLParen
+	filterTokenRParen
+)
+
+type filterToken struct {
+	kind  filterTokenKind
+	value string
+	pos   int
+}
+
+func (t filterToken) String() string {
+	if t.kind == filterTokenEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q", t.value)
+}
+
+func (t filterToken) isKeyword(keyword string) bool {
+	return t.kind == filterTokenWord && strings.EqualFold(t.value, keyword)
+}
+
+func isFilterWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune(`()":=!<>`, r)
+}
+
+func lexTaskFilter(expr string) ([]filterToken, error) {
+	runes := []rune(expr)
+	tokens := make([]filterToken, 0)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: filterTokenLParen, value: "(", pos: pos})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: filterTokenRParen, value: ")", pos: pos})
+			i++
+		case r == ':' || r == '=':
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, value: string(r), pos: pos})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			operator := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				operator += "="
+			}
+			if operator == "!" {
+				return nil, &TaskFilterError{Position: pos, Message: `unexpected "!", use != or NOT`}
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, value: operator, pos: pos})
+			i += len(operator)
+		case r == '"':
+			var value strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				value.WriteRune(runes[j])
+			}
+			if j == len(runes) {
+				return nil, &TaskFilterError{Position: pos, Message: "unterminated quoted value"}
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenString, value: value.String(), pos: pos})
+			i = j + 1
+		default:
+			j := i
+			for j < len(runes) && isFilterWordRune(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord, value: string(runes[i:j]), pos: pos})
+			i = j
+		}
+	}
+
+	tokens = append(tokens, filterToken{kind: filterTokenEOF, pos: len(runes) + 1})
+	return tokens, nil
+}
+
+type taskFilterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *taskFilterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *taskFilterParser) next() filterToken {
+	token := p.tokens[p.pos]
+	if token.kind != filterTokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+// parseOr parses `and (OR and)*`.
+func (p *taskFilterParser) parseOr() (TaskFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = TaskFilterOr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses `not ([AND] not)*`, a term following another term without a keyword is ANDed.
+func (p *taskFilterParser) parseAnd() (TaskFilter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		token := p.peek()
+		if token.isKeyword("AND") {
+			p.next()
+		} else if token.kind == filterTokenEOF || token.kind == filterTokenRParen || token.isKeyword("OR") {
+			return left, nil
+		}
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = TaskFilterAnd{Left: left, Right: right}
+	}
+}
+
+// parseNot parses `NOT not | primary`.
+func (p *taskFilterParser) parseNot() (TaskFilter, error) {
+	if p.peek().isKeyword("NOT") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return TaskFilterNot{Operand: operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+// parsePrimary parses `( or ) | condition`.
+func (p *taskFilterParser) parsePrimary() (TaskFilter, error) {
+	token := p.next()
+
+	switch {
+	case token.kind == filterTokenLParen:
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != filterTokenRParen {
+			return nil, &TaskFilterError{
+				Position: closing.pos,
+				Message:  fmt.Sprintf(`expected ")" to close "(" at position %d, got %s`, token.pos, closing),
+			}
+		}
+		return filter, nil
+	case token.kind == filterTokenWord && !token.isKeyword("AND") && !token.isKeyword("OR"):
+		return p.parseCondition(token)
+	default:
+		return nil, &TaskFilterError{Position: token.pos, Message: fmt.Sprintf("expected condition, got %s", token)}
+	}
+}
+
+func (p *taskFilterParser) parseCondition(field filterToken) (TaskFilter, error) {
+	name := strings.ToLower(field.value)
+	kind, ok := taskFilterFields[name]
+	if !ok {
+		return nil, &TaskFilterError{Position: field.pos, Message: fmt.Sprintf("unknown field %q", field.value)}
+	}
+
+	if p.peek().kind != filterTokenOperator {
+		if kind == taskFilterBool {
+			return TaskFilterCondition{Field: name, Operator: TaskFilterEqual, Value: "true"}, nil
+		}
+		return nil, &TaskFilterError{
+			Position: p.peek().pos,
+			Message:  fmt.Sprintf("expected operator after %q, got %s", field.value, p.peek()),
+		}
+	}
+
+	operatorToken := p.next()
+	operator := operatorToken.value
+	if operator == ":" {
+		operator = TaskFilterEqual
+		if name == TaskFilterTitle {
+			operator = TaskFilterContains
+		}
+	}
+	if !taskFilterOperatorAllowed(kind, name, operator) {
+		return nil, &TaskFilterError{
+			Position: operatorToken.pos,
+			Message:  fmt.Sprintf("operator %q can't be used with %q", operatorToken.value, field.value),
+		}
+	}
+
+	valueToken := p.next()
+	if valueToken.kind != filterTokenWord && valueToken.kind != filterTokenString {
+		return nil, &TaskFilterError{
+			Position: valueToken.pos,
+			Message:  fmt.Sprintf("expected value of %q, got %s", field.value, valueToken),
+		}
+	}
+
+	value, err := normalizeTaskFilterValue(kind, valueToken.value)
+	if err != nil {
+		return nil, &TaskFilterError{Position: valueToken.pos, Message: err.Error()}
+	}
+
+	return TaskFilterCondition{Field: name, Operator: operator, Value: value}, nil
+}
+
+func taskFilterOperatorAllowed(kind taskFilterValueKind, field, operator string) bool {
+	switch operator {
+	case TaskFilterEqual, TaskFilterNotEqual:
+		return true
+	case TaskFilterContains:
+		return field == TaskFilterTitle
+	default:
+		return kind == taskFilterDate || kind == taskFilterPriority
+	}
+}
+
+func normalizeTaskFilterValue(kind taskFilterValueKind, value string) (string, error) {
+	switch kind {
+	case taskFilterBool:
+		switch strings.ToLower(value) {
+		case "true":
+			return "true", nil
+		case "false":
+			return "false", nil
+		}
+		return "", fmt.Errorf("expected true or false, got %q", value)
+	case taskFilterDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return "", fmt.Errorf("expected date in format yyyy-mm-dd, got %q", value)
+		}
+	case taskFilterStatus:
+		value = strings.ToLower(value)
+		if _, err := ParseTaskStatus(value); err != nil || value == "" {
+			return "", fmt.Errorf("unknown task status %q", value)
+		}
+	case taskFilterPriority:
+		value = strings.ToLower(value)
+		if _, err := ParseTaskPriority(value); err != nil || value == "" {
+			return "", fmt.Errorf("unknown task priority %q", value)
+		}
+	}
+
+	return value, nil
+}
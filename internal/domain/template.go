@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TaskTemplate is a saved set of task definitions, instantiating the template creates all of its tasks.
+type TaskTemplate struct {
+	ID          string        `json:"id" db:"id"`
+	CreatedAt   time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at" db:"updated_at"`
+	UserID      string        `json:"user_id" db:"user_id"`
+	Title       string        `json:"title" db:"title"`
+	Description string        `json:"description" db:"description"`
+	Tasks       TemplateTasks `json:"tasks" db:"tasks"`
+}
+
+// TemplateTask is due DueOffsetDays after the start date of the instantiation, tasks without an offset have no due date.
+// Titles of the task and its checklist items may contain {{variable}} placeholders.
+type TemplateTask struct {
+	CategoryID      string   `json:"category_id"`
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	Priority        string   `json:"priority"`
+	DueOffsetDays   *int     `json:"due_offset_days"`
+	DueTime         string   `json:"due_time"`
+	DueTimezone     string   `json:"due_timezone"`
+	EstimateMinutes int      `json:"estimate_minutes"`
+	ChecklistItems  []string `json:"checklist_items"`
+}
+
+// TemplateTasks are stored in a JSONB column.
+type TemplateTasks []TemplateTask
+
+func (t TemplateTasks) Value() (driver.Value, error) {
+	if t == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *TemplateTasks) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return errors.New("template tasks must be scanned from bytes")
+	}
+	return json.Unmarshal(data, t)
+}
+
+// CategoryIDs returns unique ids of the template task categories.
+func (t TemplateTasks) CategoryIDs() []string {
+	seen := make(map[string]bool, len(t))
+	ids := make([]string, 0, len(t))
+	for _, task := range t {
+		if !seen[task.CategoryID] {
+			seen[task.CategoryID] = true
+			ids = append(ids, task.CategoryID)
+		}
+	}
+	return ids
+}
+
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// SubstituteTemplateVariables replaces {{name}} placeholders with the variable values,
+// names of the variables missing from values are returned without repeats and the placeholders are kept.
+func SubstituteTemplateVariables(text string, values map[string]string) (string, []string) {
+	var missing []string
+	result := templateVariablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := templateVariablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := values[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return placeholder
+		}
+		return value
+	})
+
+	return strings.TrimSpace(result), missing
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// TimeEntry is time spent on a task, a running timer is an entry without EndedAt.
+type TimeEntry struct {
+	ID        string     `json:"id" db:"id"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	TaskID    string     `json:"task_id" db:"task_id"`
+	StartedAt time.Time  `json:"started_at" db:"started_at"`
+	EndedAt   *time.Time `json:"ended_at" db:"ended_at"`
+}
+
+func (e TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// TimeReport sums tracked time of the tasks by category and by day,
+// an entry is counted in full on the day it has started.
+type TimeReport struct {
+	Categories []CategoryTime
+	Days       []DayTime
+}
+
+type CategoryTime struct {
+	CategoryID string `json:"category_id" db:"category_id"`
+	Title      string `json:"title" db:"title"`
+	Seconds    int64  `json:"seconds" db:"seconds"`
+}
+
+type DayTime struct {
+	Date    time.Time `json:"date" db:"date"`
+	Seconds int64     `json:"seconds" db:"seconds"`
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Operations that can be undone.
+const (
+	UndoTaskUpdate = "task_update"
+	UndoTaskDelete = "task_delete"
+	UndoTaskBulk   = "task_bulk"
+)
+
+// UndoOperation brings the tasks back to their state before the operation, the id is the undo token.
+type UndoOperation struct {
+	ID        string        `json:"id" db:"id"`
+	CreatedAt time.Time     `json:"created_at" db:"created_at"`
+	ExpiresAt time.Time     `json:"expires_at" db:"expires_at"`
+	UserID    string        `json:"user_id" db:"user_id"`
+	Action    string        `json:"action" db:"action"`
+	Tasks     TaskSnapshots `json:"tasks" db:"tasks"`
+}
+
+// TaskVersion identifies the state of a task by the time of its last change,
+// a task deleted by the operation is identified by DeletedAt as deleting doesn't change UpdatedAt.
+type TaskVersion struct {
+	ID        string     `json:"id"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+// TaskSnapshot holds the task fields the operation may change as they were before it,
+// the undo fails when the task isn't in the After state anymore.
+type TaskSnapshot struct {
+	After           TaskVersion  `json:"after"`
+	CategoryID      string       `json:"category_id"`
+	Title           string       `json:"title"`
+	Description     string       `json:"description"`
+	Status          TaskStatus   `json:"status"`
+	Priority        TaskPriority `json:"priority"`
+	DueDate         *time.Time   `json:"due_date"`
+	DueTime         *string      `json:"due_time"`
+	DueTimezone     *string      `json:"due_timezone"`
+	DueAt           *time.Time   `json:"due_at"`
+	EstimateMinutes *int         `json:"estimate_minutes"`
+	RecurrenceRule  *string      `json:"recurrence_rule"`
+	Position        string       `json:"position"`
+	DeletedAt       *time.Time   `json:"deleted_at"`
+	// TagIDs are set when the operation replaced the task tags, the tags are kept otherwise
+	TagIDs []string `json:"tag_ids"`
+	// NextOccurrence is created by completing a recurring task, the undo removes it
+	NextOccurrence *TaskVersion `json:"next_occurrence,omitempty"`
+}
+
+// TaskSnapshots are stored in a JSONB column.
+type TaskSnapshots []TaskSnapshot
+
+func (s TaskSnapshots) Value() (driver.Value, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s)
+}
+
+func (s *TaskSnapshots) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return errors.New("task snapshots must be scanned from bytes")
+	}
+	return json.Unmarshal(data, s)
+}
+
+// TaskIDs returns ids of the tasks changed by the operation.
+func (s TaskSnapshots) TaskIDs() []string {
+	ids := make([]string, len(s))
+	for i, snapshot := range s {
+		ids[i] = snapshot.After.ID
+	}
+	return ids
+}
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskHistoryRoutes(tasks *gin.RouterGroup) {
+	tasks.GET("/:id/history", h.GetTaskHistory)
+}
+
+func (h *Handler) initActivityRoutes(api *gin.RouterGroup) {
+	activity := api.Group("/activity")
+	{
+		activity.Use(h.UserIdentityMiddleware)
+		activity.GET("", h.GetActivityFeed)
+	}
+}
+
+type activityResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	TaskID    string    `json:"task_id"`
+	TaskTitle string    `json:"task_title"`
+	// Action is one of: created, updated, completed, moved, deleted, restored, undone
+	Action  string                `json:"action"`
+	Changes []fieldChangeResponse `json:"changes"`
+}
+
+// fieldChangeResponse Before and After are null for empty values, tag_ids are lists of tag ids.
+type fieldChangeResponse struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+func toActivityResponse(activity domain.Activity) activityResponse {
+	changes := make([]fieldChangeResponse, len(activity.Changes))
+	for i, change := range activity.Changes {
+		changes[i] = fieldChangeResponse(change)
+	}
+
+	return activityResponse{
+		ID:        activity.ID,
+		CreatedAt: activity.CreatedAt,
+		TaskID:    activity.TaskID,
+		TaskTitle: activity.TaskTitle,
+		Action:    string(activity.Action),
+		Changes:   changes,
+	}
+}
+
+func toActivitiesResponse(activities []domain.Activity) []activityResponse {
+	activitiesList := make([]activityResponse, len(activities))
+	for i, activity := range activities {
+		activitiesList[i] = toActivityResponse(activity)
+	}
+	return activitiesList
+}
+
+// GetTaskHistory @Summary Get Task History
+// @Security ApiKeyAuth
+// @Tags activity
+// @Description get changes of the task with field values before and after each change, newest changes go first
+// @ModuleID getTaskHistory
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {array} activityResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/history [get]
+func (h *Handler) GetTaskHistory(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	activities, err := h.services.Activity.GetTaskHistory(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toActivitiesResponse(activities))
+}
+
+// GetActivityFeed @Summary Get Activity Feed
+// @Security ApiKeyAuth
+// @Tags activity
+// @Description get changes of all tasks of the user, newest changes go first
+// @ModuleID getActivityFeed
+// @Accept  json
+// @Produce  json
+// @Param page query int false "page number" default(1)
+// @Param limit query int false "items per page" default(20)
+// @Param cursor query string false "Opaque cursor from next_cursor, pass an empty value to get the first page in cursor mode. Page is ignored and totals are not returned in cursor mode"
+// @Success 200 {object} paginatedResponse[activityResponse]
+// @Failure 400,401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /activity [get]
+func (h *Handler) GetActivityFeed(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var query domain.PaginationQuery
+	if err := c.BindQuery(&query); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, query)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	query.NormalizePagination()
+
+	res, err := h.services.Activity.GetFeed(c, userID, query)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrInvalidCursor) {
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	activities := toActivitiesResponse(res.Items)
+	if query.CursorMode() {
+		c.JSON(http.StatusOK, newCursorPaginatedResponse(*query.Cursor, res.NextCursor, query.Limit, activities))
+		return
+	}
+
+	c.JSON(http.StatusOK, newPagePaginatedResponse(query.Page, query.Limit, res.TotalPages, res.TotalItems, activities))
+}
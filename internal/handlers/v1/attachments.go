@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"mime"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+// multipartOverhead is the size allowed for multipart headers and boundaries of an upload besides the file.
+const multipartOverhead = 16 << 10
+
+func (h *Handler) initAttachmentsRoutes(tasks *gin.RouterGroup) {
+	attachments := tasks.Group("/:id/attachments")
+	{
+		attachments.GET("", h.GetAllAttachments)
+		attachments.POST("", h.UploadAttachment)
+		attachments.GET("/:attachment_id", h.DownloadAttachment)
+		attachments.DELETE("/:attachment_id", h.DeleteAttachment)
+	}
+}
+
+type attachmentResponse struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	// Size is in bytes
+	Size int64 `json:"size"`
+}
+
+func toAttachmentResponse(attachment domain.Attachment) attachmentResponse {
+	return attachmentResponse{
+		ID:          attachment.ID,
+		CreatedAt:   attachment.CreatedAt,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+	}
+}
+
+func toAttachmentsResponse(attachments []domain.Attachment) []attachmentResponse {
+	attachmentsList := make([]attachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		attachmentsList[i] = toAttachmentResponse(attachment)
+	}
+	return attachmentsList
+}
+
+// GetAllAttachments @Summary Get Attachments
+// @Security ApiKeyAuth
+// @Tags attachments
+// @Description get files attached to the task, oldest files go first
+// @ModuleID getAttachments
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {array} attachmentResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/attachments [get]
+func (h *Handler) GetAllAttachments(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	attachments, err := h.services.Attachments.GetList(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toAttachmentsResponse(attachments))
+}
+
+// UploadAttachment @Summary Upload Attachment
+// @Security ApiKeyAuth
+// @Tags attachments
+// @Description attach file to the task, the file type is detected by its contents
+// @ModuleID uploadAttachment
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param id path string true "task id"
+// @Param file formData file true "attached file"
+// @Success 201 {object} attachmentResponse
+// @Failure 400,401,404,413,415 {object} errorResponse
+// @Failure 403 {object} errorResponse "storage quota exceeded"
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/attachments [post]
+func (h *Handler) UploadAttachment(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// the body is limited before parsing, so a too large file isn't read to the end
+	maxBodySize := h.services.Attachments.MaxFileSize() + multipartOverhead
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			newErrorResponse(c, http.StatusRequestEntityTooLarge, customErrors.ErrAttachmentTooLarge.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, map[string]string{"file": "is required"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.services.Attachments.Upload(c, service.UploadAttachmentInput{
+		TaskID:   taskID,
+		UserID:   userID,
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrAttachmentTooLarge):
+			newErrorResponse(c, http.StatusRequestEntityTooLarge, err.Error())
+		case errors.Is(err, customErrors.ErrAttachmentContentType):
+			newErrorResponse(c, http.StatusUnsupportedMediaType, err.Error())
+		case errors.Is(err, customErrors.ErrStorageQuotaExceeded):
+			newErrorResponse(c, http.StatusForbidden, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toAttachmentResponse(attachment))
+}
+
+// DownloadAttachment @Summary Download Attachment
+// @Security ApiKeyAuth
+// @Tags attachments
+// @Description download file attached to the task
+// @ModuleID downloadAttachment
+// @Accept  json
+// @Produce  octet-stream
+// @Param id path string true "task id"
+// @Param attachment_id path string true "attachment id"
+// @Success 200 {file} file
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/attachments/{attachment_id} [get]
+func (h *Handler) DownloadAttachment(c *gin.Context) {
+	taskID := c.Param("id")
+	attachmentID := c.Param("attachment_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	attachment, content, err := h.services.Attachments.Download(c, attachmentID, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) || errors.Is(err, customErrors.ErrAttachmentNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer content.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    disposition,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// DeleteAttachment @Summary Delete Attachment
+// @Security ApiKeyAuth
+// @Tags attachments
+// @Description delete file attached to the task
+// @ModuleID deleteAttachment
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param attachment_id path string true "attachment id"
+// @Success 204
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/attachments/{attachment_id} [delete]
+func (h *Handler) DeleteAttachment(c *gin.Context) {
+	taskID := c.Param("id")
+	attachmentID := c.Param("attachment_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = h.services.Attachments.Delete(c, attachmentID, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) || errors.Is(err, customErrors.ErrAttachmentNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -17,6 +17,7 @@ func (h *Handler) initCategoriesRoutes(api *gin.RouterGroup) {
 		categories.POST("", h.CreateCategory)
 		categories.PUT("/:id", h.UpdateCategory)
 		categories.DELETE("/:id", h.DeleteCategory)
+		categories.POST("/:id/restore", h.RestoreCategory)
 	}
 }
 
@@ -38,6 +39,8 @@ type categoryResponse struct {
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	Color       string    `json:"color"`
+	// DeletedAt is returned for categories in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // GetAllCategories @Summary Get Categories
@@ -207,7 +210,7 @@ func (h *Handler) UpdateCategory(c *gin.Context) {
 // DeleteCategory @Summary Delete Category
 // @Security ApiKeyAuth
 // @Tags categories
-// @Description delete category
+// @Description move category and its tasks to the trash
 // @ModuleID deleteCategory
 // @Accept  json
 // @Produce  json
@@ -237,3 +240,40 @@ func (h *Handler) DeleteCategory(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// RestoreCategory @Summary Restore Category
+// @Security ApiKeyAuth
+// @Tags categories
+// @Description restore category from the trash with the tasks deleted together with it
+// @ModuleID restoreCategory
+// @Accept  json
+// @Produce  json
+// @Param id path string true "category id"
+// @Success 200 {object} categoryResponse
+// @Failure 401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /categories/{id}/restore [post]
+func (h *Handler) RestoreCategory(c *gin.Context) {
+	categoryID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	category, err := h.services.Categories.Restore(c, categoryID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrCategoryNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrCategoryAlreadyExists), errors.Is(err, customErrors.ErrTaskAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toCategoryResponse(category))
+}
@@ -0,0 +1,299 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initChecklistItemsRoutes(tasks *gin.RouterGroup) {
+	items := tasks.Group("/:id/items")
+	{
+		items.GET("", h.GetAllChecklistItems)
+		items.POST("", h.CreateChecklistItem)
+		items.POST("/reorder", h.ReorderChecklistItems)
+		items.PUT("/:item_id", h.UpdateChecklistItem)
+		items.DELETE("/:item_id", h.DeleteChecklistItem)
+	}
+}
+
+type createChecklistItemInput struct {
+	Title     string `json:"title" binding:"required,min=1,max=255"`
+	Completed bool   `json:"completed"`
+}
+
+// updateChecklistItemInput with complete_task set completes the task when all of its items are completed.
+type updateChecklistItemInput struct {
+	Title        *string `json:"title" binding:"omitempty,min=1,max=255"`
+	Completed    *bool   `json:"completed" binding:"omitempty"`
+	CompleteTask bool    `json:"complete_task"`
+}
+
+type reorderChecklistItemsInput struct {
+	ItemIDs []string `json:"item_ids" binding:"required,min=1,dive,uuid"`
+}
+
+type checklistItemResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Title     string    `json:"title"`
+	Completed bool      `json:"completed"`
+	Position  int       `json:"position"`
+}
+
+type checklistProgressResponse struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+func toChecklistItemResponse(item domain.ChecklistItem) checklistItemResponse {
+	return checklistItemResponse{
+		ID:        item.ID,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+		Title:     item.Title,
+		Completed: item.Completed,
+		Position:  item.Position,
+	}
+}
+
+func toChecklistItemsResponse(items []domain.ChecklistItem) []checklistItemResponse {
+	itemsList := make([]checklistItemResponse, len(items))
+	for i, item := range items {
+		itemsList[i] = toChecklistItemResponse(item)
+	}
+	return itemsList
+}
+
+// GetAllChecklistItems @Summary Get Checklist Items
+// @Security ApiKeyAuth
+// @Tags checklist items
+// @Description get checklist items of the task ordered by position
+// @ModuleID getChecklistItems
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {array} checklistItemResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/items [get]
+func (h *Handler) GetAllChecklistItems(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	items, err := h.services.ChecklistItems.GetList(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toChecklistItemsResponse(items))
+}
+
+// CreateChecklistItem @Summary Create Checklist Item
+// @Security ApiKeyAuth
+// @Tags checklist items
+// @Description create checklist item at the end of the task checklist
+// @ModuleID createChecklistItem
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body createChecklistItemInput true "checklist item info"
+// @Success 201 {object} checklistItemResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/items [post]
+func (h *Handler) CreateChecklistItem(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp createChecklistItemInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	item, err := h.services.ChecklistItems.Create(c, service.CreateChecklistItemInput{
+		TaskID:    taskID,
+		UserID:    userID,
+		Title:     inp.Title,
+		Completed: inp.Completed,
+	})
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, toChecklistItemResponse(item))
+}
+
+// UpdateChecklistItem @Summary Update Checklist Item
+// @Security ApiKeyAuth
+// @Tags checklist items
+// @Description update checklist item, set complete_task to complete the task when all of its items are completed
+// @ModuleID updateChecklistItem
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param item_id path string true "checklist item id"
+// @Param input body updateChecklistItemInput true "update checklist item info"
+// @Success 200 {object} checklistItemResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/items/{item_id} [put]
+func (h *Handler) UpdateChecklistItem(c *gin.Context) {
+	taskID := c.Param("id")
+	itemID := c.Param("item_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp updateChecklistItemInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	item, err := h.services.ChecklistItems.Update(c, service.UpdateChecklistItemInput{
+		ID:           itemID,
+		TaskID:       taskID,
+		UserID:       userID,
+		Title:        inp.Title,
+		Completed:    inp.Completed,
+		CompleteTask: inp.CompleteTask,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound), errors.Is(err, customErrors.ErrChecklistItemNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrNoUpdateFields):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toChecklistItemResponse(item))
+}
+
+// DeleteChecklistItem @Summary Delete Checklist Item
+// @Security ApiKeyAuth
+// @Tags checklist items
+// @Description delete checklist item
+// @ModuleID deleteChecklistItem
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param item_id path string true "checklist item id"
+// @Success 204
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/items/{item_id} [delete]
+func (h *Handler) DeleteChecklistItem(c *gin.Context) {
+	taskID := c.Param("id")
+	itemID := c.Param("item_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = h.services.ChecklistItems.Delete(c, itemID, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) || errors.Is(err, customErrors.ErrChecklistItemNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReorderChecklistItems @Summary Reorder Checklist Items
+// @Security ApiKeyAuth
+// @Tags checklist items
+// @Description reorder checklist items, item_ids must list every item of the task in the new order
+// @ModuleID reorderChecklistItems
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body reorderChecklistItemsInput true "checklist item ids in the new order"
+// @Success 200 {array} checklistItemResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/items/reorder [post]
+func (h *Handler) ReorderChecklistItems(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp reorderChecklistItemsInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	items, err := h.services.ChecklistItems.Reorder(c, taskID, userID, inp.ItemIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrInvalidChecklistOrder):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toChecklistItemsResponse(items))
+}
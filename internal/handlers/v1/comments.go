@@ -0,0 +1,227 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initCommentsRoutes(tasks *gin.RouterGroup) {
+	comments := tasks.Group("/:id/comments")
+	{
+		comments.GET("", h.GetAllComments)
+		comments.POST("", h.CreateComment)
+		comments.PUT("/:comment_id", h.UpdateComment)
+		comments.DELETE("/:comment_id", h.DeleteComment)
+	}
+}
+
+type commentInput struct {
+	Body string `json:"body" binding:"required,min=1,max=5000"`
+}
+
+type commentResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	AuthorID  string    `json:"author_id"`
+	Body      string    `json:"body"`
+}
+
+func toCommentResponse(comment domain.Comment) commentResponse {
+	return commentResponse{
+		ID:        comment.ID,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		AuthorID:  comment.UserID,
+		Body:      comment.Body,
+	}
+}
+
+// GetAllComments @Summary Get Comments
+// @Security ApiKeyAuth
+// @Tags comments
+// @Description get comments of the task, oldest comments go first
+// @ModuleID getComments
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {array} commentResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/comments [get]
+func (h *Handler) GetAllComments(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	comments, err := h.services.Comments.GetList(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	commentsList := make([]commentResponse, len(comments))
+	for i, comment := range comments {
+		commentsList[i] = toCommentResponse(comment)
+	}
+
+	c.JSON(http.StatusOK, commentsList)
+}
+
+// CreateComment @Summary Create Comment
+// @Security ApiKeyAuth
+// @Tags comments
+// @Description add comment to the task
+// @ModuleID createComment
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body commentInput true "comment info"
+// @Success 201 {object} commentResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/comments [post]
+func (h *Handler) CreateComment(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp commentInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	comment, err := h.services.Comments.Create(c, service.CreateCommentInput{
+		TaskID: taskID,
+		UserID: userID,
+		Body:   inp.Body,
+	})
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCommentResponse(comment))
+}
+
+// UpdateComment @Summary Update Comment
+// @Security ApiKeyAuth
+// @Tags comments
+// @Description edit comment, only the author can edit it
+// @ModuleID updateComment
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param comment_id path string true "comment id"
+// @Param input body commentInput true "comment info"
+// @Success 200 {object} commentResponse
+// @Failure 400,401,403,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/comments/{comment_id} [put]
+func (h *Handler) UpdateComment(c *gin.Context) {
+	taskID := c.Param("id")
+	commentID := c.Param("comment_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp commentInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	comment, err := h.services.Comments.Update(c, service.UpdateCommentInput{
+		ID:     commentID,
+		TaskID: taskID,
+		UserID: userID,
+		Body:   inp.Body,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound), errors.Is(err, customErrors.ErrCommentNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrNotCommentAuthor):
+			newErrorResponse(c, http.StatusForbidden, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommentResponse(comment))
+}
+
+// DeleteComment @Summary Delete Comment
+// @Security ApiKeyAuth
+// @Tags comments
+// @Description delete comment, only the author can delete it
+// @ModuleID deleteComment
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param comment_id path string true "comment id"
+// @Success 204
+// @Failure 400,401,403,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/comments/{comment_id} [delete]
+func (h *Handler) DeleteComment(c *gin.Context) {
+	taskID := c.Param("id")
+	commentID := c.Param("comment_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = h.services.Comments.Delete(c, commentID, taskID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound), errors.Is(err, customErrors.ErrCommentNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrNotCommentAuthor):
+			newErrorResponse(c, http.StatusForbidden, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
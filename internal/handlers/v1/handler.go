@@ -22,5 +22,12 @@ func (h *Handler) Init(api *gin.RouterGroup) {
 		h.initUsersRoutes(v1)
 		h.initCategoriesRoutes(v1)
 		h.initTasksRoutes(v1)
+		h.initTagsRoutes(v1)
+		h.initTrashRoutes(v1)
+		h.initTimeTrackingRoutes(v1)
+		h.initActivityRoutes(v1)
+		h.initUndoRoutes(v1)
+		h.initTemplatesRoutes(v1)
+		h.initSmartListsRoutes(v1)
 	}
 }
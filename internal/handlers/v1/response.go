@@ -7,12 +7,35 @@ import (
 	"todo_list_go/pkg/logger"
 )
 
+// paginatedResponse has page, total_pages and total_items in page mode
+// and cursor, next_cursor in cursor mode. next_cursor is omitted on the last page.
 type paginatedResponse[T any] struct {
-	Page       int   `json:"page"`
-	Limit      int   `json:"limit"`
-	TotalPages int   `json:"total_pages"`
-	Total      int64 `json:"total_items"`
-	Items      []T   `json:"items"`
+	Page       *int    `json:"page,omitempty"`
+	Limit      int     `json:"limit"`
+	TotalPages *int    `json:"total_pages,omitempty"`
+	Total      *int64  `json:"total_items,omitempty"`
+	Cursor     *string `json:"cursor,omitempty"`
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Items      []T     `json:"items"`
+}
+
+func newPagePaginatedResponse[T any](page, limit, totalPages int, total int64, items []T) paginatedResponse[T] {
+	return paginatedResponse[T]{
+		Page:       &page,
+		Limit:      limit,
+		TotalPages: &totalPages,
+		Total:      &total,
+		Items:      items,
+	}
+}
+
+func newCursorPaginatedResponse[T any](cursor string, nextCursor *string, limit int, items []T) paginatedResponse[T] {
+	return paginatedResponse[T]{
+		Limit:      limit,
+		Cursor:     &cursor,
+		NextCursor: nextCursor,
+		Items:      items,
+	}
 }
 
 type errorBodyResponse struct {
@@ -0,0 +1,390 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initSmartListsRoutes(api *gin.RouterGroup) {
+	smartLists := api.Group("/smart-lists")
+	{
+		smartLists.Use(h.UserIdentityMiddleware)
+		smartLists.GET("", h.GetAllSmartLists)
+		smartLists.POST("", h.CreateSmartList)
+		smartLists.GET("/:id", h.GetSmartListById)
+		smartLists.PUT("/:id", h.UpdateSmartList)
+		smartLists.DELETE("/:id", h.DeleteSmartList)
+		smartLists.GET("/:id/tasks", h.GetSmartListTasks)
+	}
+}
+
+// dateRangeInput is either a relative range like "today", "this week" or "last 7 days"
+// or from and to bounds, each bound is YYYY-MM-DD or one of: today, yesterday, tomorrow.
+type dateRangeInput struct {
+	Relative string `json:"relative" binding:"omitempty,max=50"`
+	From     string `json:"from" binding:"omitempty,max=10"`
+	To       string `json:"to" binding:"omitempty,max=10"`
+}
+
+// smartListQueryInput has the filters and sort of the task list, dates are taken in timezone.
+type smartListQueryInput struct {
+	Completed   *bool          `json:"completed"`
+	Archived    *bool          `json:"archived"`
+	CategoryIDs []string       `json:"category_ids" binding:"omitempty,max=100,dive,uuid"`
+	Overdue     *bool          `json:"overdue"`
+	Blocked     *bool          `json:"blocked"`
+	Priorities  []string       `json:"priorities" binding:"omitempty,dive,oneof=none low medium high urgent"`
+	Statuses    []string       `json:"statuses" binding:"omitempty,dive,oneof=todo in_progress blocked done"`
+	TagIDs      []string       `json:"tag_ids" binding:"omitempty,max=100,dive,uuid"`
+	Tags        []string       `json:"tags" binding:"omitempty,max=100,dive,min=1,max=255"`
+	TagMatch    string         `json:"tag_match" binding:"omitempty,oneof=any all"`
+	Q           string         `json:"q" binding:"omitempty,max=255"`
+	Filter      string         `json:"filter" binding:"omitempty,max=1000"`
+	CreatedAt   dateRangeInput `json:"created_at"`
+	UpdatedAt   dateRangeInput `json:"updated_at"`
+	DueDate     dateRangeInput `json:"due_date"`
+	Timezone    string         `json:"timezone" binding:"omitempty,timezone"`
+	Sort        string         `json:"sort" binding:"omitempty,sort_fields=created_at updated_at title priority due_date category_title relevance position"`
+}
+
+func (q smartListQueryInput) toSmartListQuery() domain.SmartListQuery {
+	return domain.SmartListQuery{
+		Completed:   q.Completed,
+		Archived:    q.Archived,
+		CategoryIDs: q.CategoryIDs,
+		Overdue:     q.Overdue,
+		Blocked:     q.Blocked,
+		Priorities:  q.Priorities,
+		Statuses:    q.Statuses,
+		TagIDs:      q.TagIDs,
+		Tags:        q.Tags,
+		TagMatch:    q.TagMatch,
+		Q:           q.Q,
+		Filter:      q.Filter,
+		CreatedAt:   domain.DateRange(q.CreatedAt),
+		UpdatedAt:   domain.DateRange(q.UpdatedAt),
+		DueDate:     domain.DateRange(q.DueDate),
+		Timezone:    q.Timezone,
+		Sort:        q.Sort,
+	}
+}
+
+type createSmartListInput struct {
+	Title string              `json:"title" binding:"required,min=1,max=255"`
+	Query smartListQueryInput `json:"query"`
+}
+
+// updateSmartListInput query replaces the whole query of the list.
+type updateSmartListInput struct {
+	Title *string              `json:"title" binding:"omitempty,min=1,max=255"`
+	Query *smartListQueryInput `json:"query"`
+}
+
+type smartListResponse struct {
+	ID        string                `json:"id"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+	Title     string                `json:"title"`
+	Query     domain.SmartListQuery `json:"query"`
+}
+
+func toSmartListResponse(list domain.SmartList) smartListResponse {
+	return smartListResponse{
+		ID:        list.ID,
+		CreatedAt: list.CreatedAt,
+		UpdatedAt: list.UpdatedAt,
+		Title:     list.Title,
+		Query:     list.Query,
+	}
+}
+
+func toSmartListsResponse(lists []domain.SmartList) []smartListResponse {
+	smartLists := make([]smartListResponse, len(lists))
+	for i, list := range lists {
+		smartLists[i] = toSmartListResponse(list)
+	}
+	return smartLists
+}
+
+// GetAllSmartLists @Summary Get Smart Lists
+// @Security ApiKeyAuth
+// @Tags smart-lists
+// @Description get smart lists ordered by title
+// @ModuleID getSmartLists
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} smartListResponse
+// @Failure 401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /smart-lists [get]
+func (h *Handler) GetAllSmartLists(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	lists, err := h.services.SmartLists.GetList(c, userID)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toSmartListsResponse(lists))
+}
+
+// CreateSmartList @Summary Create Smart List
+// @Security ApiKeyAuth
+// @Tags smart-lists
+// @Description save task list query as smart list, relative dates are resolved every time the list is run
+// @ModuleID createSmartList
+// @Accept  json
+// @Produce  json
+// @Param input body createSmartListInput true "smart list info"
+// @Success 201 {object} smartListResponse
+// @Failure 400,401,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /smart-lists [post]
+func (h *Handler) CreateSmartList(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp createSmartListInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	list, err := h.services.SmartLists.Create(c, service.CreateSmartListInput{
+		UserID: userID,
+		Title:  inp.Title,
+		Query:  inp.Query.toSmartListQuery(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrInvalidSmartListQuery):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrSmartListAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toSmartListResponse(list))
+}
+
+// GetSmartListById @Summary Get Smart List By Id
+// @Security ApiKeyAuth
+// @Tags smart-lists
+// @Description get smart list by id
+// @ModuleID getSmartListById
+// @Accept  json
+// @Produce  json
+// @Param id path string true "smart list id"
+// @Success 200 {object} smartListResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /smart-lists/{id} [get]
+func (h *Handler) GetSmartListById(c *gin.Context) {
+	listID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	list, err := h.services.SmartLists.GetByID(c, listID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrSmartListNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toSmartListResponse(list))
+}
+
+// UpdateSmartList @Summary Update Smart List
+// @Security ApiKeyAuth
+// @Tags smart-lists
+// @Description update smart list, query replaces the whole query of the list
+// @ModuleID updateSmartList
+// @Accept  json
+// @Produce  json
+// @Param id path string true "smart list id"
+// @Param input body updateSmartListInput true "update smart list info"
+// @Success 200 {object} smartListResponse
+// @Failure 400,401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /smart-lists/{id} [put]
+func (h *Handler) UpdateSmartList(c *gin.Context) {
+	listID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp updateSmartListInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var query *domain.SmartListQuery
+	if inp.Query != nil {
+		listQuery := inp.Query.toSmartListQuery()
+		query = &listQuery
+	}
+
+	list, err := h.services.SmartLists.Update(c, service.UpdateSmartListInput{
+		ID:     listID,
+		UserID: userID,
+		Title:  inp.Title,
+		Query:  query,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrSmartListNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrInvalidSmartListQuery), errors.Is(err, customErrors.ErrNoUpdateFields):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrSmartListAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toSmartListResponse(list))
+}
+
+// DeleteSmartList @Summary Delete Smart List
+// @Security ApiKeyAuth
+// @Tags smart-lists
+// @Description delete smart list, its tasks are kept
+// @ModuleID deleteSmartList
+// @Accept  json
+// @Produce  json
+// @Param id path string true "smart list id"
+// @Success 204
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /smart-lists/{id} [delete]
+func (h *Handler) DeleteSmartList(c *gin.Context) {
+	listID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.services.SmartLists.Delete(c, listID, userID); err != nil {
+		if errors.Is(err, customErrors.ErrSmartListNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetSmartListTasks @Summary Get Smart List Tasks
+// @Security ApiKeyAuth
+// @Tags smart-lists
+// @Description run the query of the smart list, relative dates are resolved at the moment of the request
+// @ModuleID getSmartListTasks
+// @Accept  json
+// @Produce  json
+// @Param id path string true "smart list id"
+// @Param page query int false "page number" default(1)
+// @Param limit query int false "items per page" default(20)
+// @Param cursor query string false "Opaque cursor from next_cursor, pass an empty value to get the first page in cursor mode. Page is ignored and totals are not returned in cursor mode"
+// @Param format query string false "markdown (default) or html to add description_html rendered from the Markdown description" Enums(markdown, html)
+// @Success 200 {array} taskResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /smart-lists/{id}/tasks [get]
+func (h *Handler) GetSmartListTasks(c *gin.Context) {
+	listID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var pagination domain.PaginationQuery
+	if err := c.BindQuery(&pagination); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, pagination)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	format, ok := bindTaskFormat(c)
+	if !ok {
+		return
+	}
+
+	pagination.NormalizePagination()
+
+	res, err := h.services.SmartLists.GetTasks(c, listID, userID, pagination)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrSmartListNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrInvalidSmartListQuery), errors.Is(err, customErrors.ErrInvalidCursor),
+			errors.Is(err, customErrors.ErrInvalidTaskFilter):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	tasksList := make([]taskResponse, len(res.Items))
+	for i, task := range res.Items {
+		tasksList[i] = format.taskResponse(task)
+	}
+
+	if pagination.CursorMode() {
+		c.JSON(http.StatusOK, newCursorPaginatedResponse(*pagination.Cursor, res.NextCursor, pagination.Limit, tasksList))
+		return
+	}
+
+	c.JSON(http.StatusOK, newPagePaginatedResponse(pagination.Page, pagination.Limit, res.TotalPages, res.TotalItems, tasksList))
+}
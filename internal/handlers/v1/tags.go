@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTagsRoutes(api *gin.RouterGroup) {
+	tags := api.Group("/tags")
+	{
+		tags.Use(h.UserIdentityMiddleware)
+		tags.GET("", h.GetAllTags)
+		tags.POST("", h.CreateTag)
+		tags.PUT("/:id", h.UpdateTag)
+		tags.DELETE("/:id", h.DeleteTag)
+	}
+}
+
+// tag titles can't contain commas, because tasks are filtered by a comma-separated list of titles
+type createTagInput struct {
+	Title string `json:"title" binding:"required,min=1,max=64,excludes=0x2C"`
+	Color string `json:"color" binding:"omitempty,oneof=red blue yellow purple green brown"`
+}
+
+type updateTagInput struct {
+	Title *string `json:"title" binding:"omitempty,min=1,max=64,excludes=0x2C"`
+	Color *string `json:"color" binding:"omitempty,oneof=red blue yellow purple green brown"`
+}
+
+type tagResponse struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Title     string    `json:"title"`
+	Color     string    `json:"color"`
+}
+
+func toTagResponse(tag domain.Tag) tagResponse {
+	return tagResponse{
+		ID:        tag.ID,
+		CreatedAt: tag.CreatedAt,
+		Title:     tag.Title,
+		Color:     tag.Color,
+	}
+}
+
+func toTagsResponse(tags []domain.Tag) []tagResponse {
+	tagsList := make([]tagResponse, len(tags))
+	for i, tag := range tags {
+		tagsList[i] = toTagResponse(tag)
+	}
+	return tagsList
+}
+
+// GetAllTags @Summary Get Tags
+// @Security ApiKeyAuth
+// @Tags tags
+// @Description get tags ordered by title
+// @ModuleID getTags
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} tagResponse
+// @Failure 401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tags [get]
+func (h *Handler) GetAllTags(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	tags, err := h.services.Tags.GetList(c, userID)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTagsResponse(tags))
+}
+
+// CreateTag @Summary Create Tag
+// @Security ApiKeyAuth
+// @Tags tags
+// @Description create tag
+// @ModuleID createTag
+// @Accept  json
+// @Produce  json
+// @Param input body createTagInput true "tag info"
+// @Success 201 {object} tagResponse
+// @Failure 400,401,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tags [post]
+func (h *Handler) CreateTag(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp createTagInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tag, err := h.services.Tags.Create(c, service.CreateTagInput{
+		UserID: userID,
+		Title:  inp.Title,
+		Color:  inp.Color,
+	})
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTagAlreadyExists) {
+			newErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTagResponse(tag))
+}
+
+// UpdateTag @Summary Update Tag
+// @Security ApiKeyAuth
+// @Tags tags
+// @Description update tag
+// @ModuleID updateTag
+// @Accept  json
+// @Produce  json
+// @Param id path string true "tag id"
+// @Param input body updateTagInput true "update tag info"
+// @Success 200 {object} tagResponse
+// @Failure 400,401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tags/{id} [put]
+func (h *Handler) UpdateTag(c *gin.Context) {
+	tagID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp updateTagInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tag, err := h.services.Tags.Update(c, service.UpdateTagInput{
+		ID:     tagID,
+		UserID: userID,
+		Title:  inp.Title,
+		Color:  inp.Color,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTagNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTagAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		case errors.Is(err, customErrors.ErrNoUpdateFields):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTagResponse(tag))
+}
+
+// DeleteTag @Summary Delete Tag
+// @Security ApiKeyAuth
+// @Tags tags
+// @Description delete tag, it is removed from all tasks
+// @ModuleID deleteTag
+// @Accept  json
+// @Produce  json
+// @Param id path string true "tag id"
+// @Success 204
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tags/{id} [delete]
+func (h *Handler) DeleteTag(c *gin.Context) {
+	tagID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.services.Tags.Delete(c, tagID, userID); err != nil {
+		if errors.Is(err, customErrors.ErrTagNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
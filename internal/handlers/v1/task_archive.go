@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskArchiveRoutes(tasks *gin.RouterGroup) {
+	tasks.POST("/archive-completed", h.ArchiveCompletedTasks)
+	tasks.POST("/:id/archive", h.ArchiveTask)
+	tasks.POST("/:id/unarchive", h.UnarchiveTask)
+}
+
+type archiveCompletedTasksInput struct {
+	CategoryID string `json:"category_id" binding:"required,uuid"`
+}
+
+type archiveCompletedTasksResponse struct {
+	Archived int64 `json:"archived"`
+}
+
+// ArchiveTask @Summary Archive Task
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description archive task, archived tasks are hidden from the task list unless archived=true is requested
+// @ModuleID archiveTask
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {object} taskResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/archive [post]
+func (h *Handler) ArchiveTask(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.Archive(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+// UnarchiveTask @Summary Unarchive Task
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description return archived task to the task list
+// @ModuleID unarchiveTask
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {object} taskResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/unarchive [post]
+func (h *Handler) UnarchiveTask(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.Unarchive(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+// ArchiveCompletedTasks @Summary Archive Completed Tasks
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description archive all completed tasks of the category
+// @ModuleID archiveCompletedTasks
+// @Accept  json
+// @Produce  json
+// @Param input body archiveCompletedTasksInput true "category"
+// @Success 200 {object} archiveCompletedTasksResponse
+// @Failure 400,401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/archive-completed [post]
+func (h *Handler) ArchiveCompletedTasks(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp archiveCompletedTasksInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	archived, err := h.services.Tasks.ArchiveCompleted(c, inp.CategoryID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrCategoryNotFound) {
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, archiveCompletedTasksResponse{Archived: archived})
+}
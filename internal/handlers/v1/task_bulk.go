@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskBulkRoutes(tasks *gin.RouterGroup) {
+	tasks.POST("/bulk", h.BulkTasks)
+}
+
+// bulkTasksInput targets tasks either by task_ids or by filter.
+// category_id is used by the move action, priority and due fields by the update action.
+type bulkTasksInput struct {
+	Action      string                `json:"action" binding:"required,oneof=complete uncomplete move delete update"`
+	TaskIDs     []string              `json:"task_ids" binding:"required_without=Filter,excluded_with=Filter,omitempty,min=1,max=100,dive,uuid"`
+	Filter      *bulkTasksFilterInput `json:"filter" binding:"omitempty"`
+	CategoryID  string                `json:"category_id" binding:"required_if=Action move,excluded_unless=Action move,omitempty,uuid"`
+	Priority    *string               `json:"priority" binding:"excluded_unless=Action update,omitempty,oneof=none low medium high urgent"`
+	DueDate     *string               `json:"due_date" binding:"excluded_unless=Action update,omitempty,eq=|datetime=2006-01-02"`
+	DueTime     *string               `json:"due_time" binding:"excluded_unless=Action update,omitempty,eq=|datetime=15:04"`
+	DueTimezone *string               `json:"due_timezone" binding:"excluded_unless=Action update,omitempty,eq=|timezone"`
+}
+
+// bulkTasksFilterInput has the same meaning as the task list filters.
+type bulkTasksFilterInput struct {
+	Completed   *bool    `json:"completed"`
+	Archived    *bool    `json:"archived"`
+	CategoryIDs []string `json:"category_ids" binding:"omitempty,dive,uuid"`
+	DueDateFrom string   `json:"due_date_from" binding:"omitempty,datetime=2006-01-02"`
+	DueDateTo   string   `json:"due_date_to" binding:"omitempty,datetime=2006-01-02"`
+	Overdue     *bool    `json:"overdue"`
+	Blocked     *bool    `json:"blocked"`
+	Priorities  []string `json:"priorities" binding:"omitempty,dive,oneof=none low medium high urgent"`
+	Statuses    []string `json:"statuses" binding:"omitempty,dive,oneof=todo in_progress blocked done"`
+	TagIDs      []string `json:"tag_ids" binding:"omitempty,dive,uuid"`
+	Tags        []string `json:"tags"`
+	TagMatch    string   `json:"tag_match" binding:"omitempty,oneof=any all"`
+	Q           string   `json:"q" binding:"omitempty,max=255"`
+	Filter      string   `json:"filter" binding:"omitempty,max=1000"`
+}
+
+func (f bulkTasksFilterInput) toTaskFilters() domain.TaskFiltersQuery {
+	filters := domain.TaskFiltersQuery{
+		Completed:   f.Completed,
+		Archived:    f.Archived,
+		CategoryIDs: f.CategoryIDs,
+		DueDateFrom: f.DueDateFrom,
+		DueDateTo:   f.DueDateTo,
+		Overdue:     f.Overdue,
+		Blocked:     f.Blocked,
+		Priorities:  f.Priorities,
+		Statuses:    f.Statuses,
+		TagIDs:      f.TagIDs,
+		Tags:        f.Tags,
+		TagMatch:    f.TagMatch,
+		Q:           f.Q,
+		Filter:      f.Filter,
+	}
+	filters.NormalizeFilters()
+
+	return filters
+}
+
+type bulkTaskResultResponse struct {
+	ID      string `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+type bulkTasksResponse struct {
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Results   []bulkTaskResultResponse `json:"results"`
+}
+
+// BulkTasks @Summary Bulk Task Operation
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description complete, uncomplete, move, delete or update up to 100 tasks in one transaction.
+// @Description Tasks are given by task_ids or by filter, tasks that can't be changed are reported in the results
+// @ModuleID bulkTasks
+// @Accept  json
+// @Produce  json
+// @Param input body bulkTasksInput true "bulk operation"
+// @Success 200 {object} bulkTasksResponse
+// @Header 200 {string} X-Undo-Token "token to undo the changes of the succeeded tasks"
+// @Header 200 {string} X-Undo-Expires-At "expiration time of the undo token"
+// @Failure 400,401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/bulk [post]
+func (h *Handler) BulkTasks(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp bulkTasksInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	bulkInput := service.BulkTaskInput{
+		UserID:      userID,
+		Action:      inp.Action,
+		TaskIDs:     inp.TaskIDs,
+		CategoryID:  inp.CategoryID,
+		Priority:    inp.Priority,
+		DueDate:     inp.DueDate,
+		DueTime:     inp.DueTime,
+		DueTimezone: inp.DueTimezone,
+	}
+	if inp.Filter != nil {
+		filters := inp.Filter.toTaskFilters()
+		bulkInput.Filters = &filters
+	}
+
+	results, undoToken, err := h.services.Tasks.Bulk(c, bulkInput)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrCategoryNotFound), errors.Is(err, customErrors.ErrTooManyBulkTasks),
+			errors.Is(err, customErrors.ErrNoUpdateFields), errors.Is(err, customErrors.ErrInvalidTaskFilter):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	res := bulkTasksResponse{Results: make([]bulkTaskResultResponse, len(results))}
+	for i, result := range results {
+		res.Results[i] = bulkTaskResultResponse{ID: result.TaskID, Success: result.Err == nil}
+		if result.Err != nil {
+			res.Results[i].Error = result.Err.Error()
+			res.Failed++
+			continue
+		}
+		res.Succeeded++
+	}
+
+	setUndoHeaders(c, undoToken)
+	c.JSON(http.StatusOK, res)
+}
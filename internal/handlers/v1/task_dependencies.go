@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskDependencyRoutes(tasks *gin.RouterGroup) {
+	tasks.POST("/:id/dependencies", h.AddTaskDependency)
+	tasks.DELETE("/:id/dependencies/:blocker_id", h.RemoveTaskDependency)
+}
+
+type addTaskDependencyInput struct {
+	BlockerID string `json:"blocker_id" binding:"required,uuid"`
+}
+
+// AddTaskDependency @Summary Add Task Dependency
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description mark the task as blocked by another task of the user, the task can't be completed until the blocker is done
+// @ModuleID addTaskDependency
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body addTaskDependencyInput true "blocking task"
+// @Success 200 {object} taskResponse
+// @Failure 400,401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/dependencies [post]
+func (h *Handler) AddTaskDependency(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp addTaskDependencyInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.AddDependency(c, taskID, inp.BlockerID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrBlockerNotFound), errors.Is(err, customErrors.ErrTaskDependencyCycle):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrTaskDependencyAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+// RemoveTaskDependency @Summary Remove Task Dependency
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description remove the blocking task from the dependencies of the task
+// @ModuleID removeTaskDependency
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param blocker_id path string true "blocking task id"
+// @Success 204
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/dependencies/{blocker_id} [delete]
+func (h *Handler) RemoveTaskDependency(c *gin.Context) {
+	taskID := c.Param("id")
+	blockerID := c.Param("blocker_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = h.services.Tasks.RemoveDependency(c, taskID, blockerID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) || errors.Is(err, customErrors.ErrTaskDependencyNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskPositionRoutes(tasks *gin.RouterGroup) {
+	tasks.POST("/:id/move", h.MoveTask)
+}
+
+// moveTaskInput gives the new neighbours of the task in its category,
+// after_id is omitted when the task is moved to the start and before_id when it is moved to the end.
+type moveTaskInput struct {
+	AfterID  string `json:"after_id" binding:"omitempty,uuid"`
+	BeforeID string `json:"before_id" binding:"required_without=AfterID,omitempty,uuid"`
+}
+
+// MoveTask @Summary Move Task
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description place task between other tasks of its category, the list is ordered this way with sort=position
+// @ModuleID moveTask
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body moveTaskInput true "new neighbours"
+// @Success 200 {object} taskResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/move [post]
+func (h *Handler) MoveTask(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp moveTaskInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.Move(c, service.MoveTaskInput{
+		ID:       taskID,
+		UserID:   userID,
+		AfterID:  inp.AfterID,
+		BeforeID: inp.BeforeID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTaskNeighbour), errors.Is(err, customErrors.ErrInvalidTaskMove):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
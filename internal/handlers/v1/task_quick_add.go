@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskQuickAddRoutes(tasks *gin.RouterGroup) {
+	tasks.POST("/quick", h.QuickAddTask)
+}
+
+// quickAddTaskInput text is a single line like "Pay rent tomorrow 9am #Finance !high @bills",
+// category_id is used when the text has no #category. Relative dates are taken in timezone, UTC by default.
+type quickAddTaskInput struct {
+	Text       string `json:"text" binding:"required,min=1,max=500"`
+	CategoryID string `json:"category_id" binding:"omitempty,uuid"`
+	Timezone   string `json:"timezone" binding:"omitempty,timezone"`
+	// DryRun returns the parsed fields without creating the task
+	DryRun bool `json:"dry_run"`
+}
+
+// quickAddPreviewResponse is the task parsed from the text in dry-run mode.
+type quickAddPreviewResponse struct {
+	Title       string           `json:"title"`
+	Category    categoryResponse `json:"category"`
+	Priority    string           `json:"priority"`
+	DueDate     *string          `json:"due_date"`
+	DueTime     *string          `json:"due_time"`
+	DueTimezone *string          `json:"due_timezone"`
+	Tags        []tagResponse    `json:"tags"`
+}
+
+func toQuickAddPreviewResponse(result service.QuickAddTaskResult) quickAddPreviewResponse {
+	res := quickAddPreviewResponse{
+		Title:       result.Input.Title,
+		Category:    toCategoryResponse(result.Category),
+		Priority:    result.Input.Priority,
+		DueDate:     emptyToNil(result.Input.DueDate),
+		DueTime:     emptyToNil(result.Input.DueTime),
+		DueTimezone: emptyToNil(result.Input.DueTimezone),
+		Tags:        toTagsResponse(result.Tags),
+	}
+	if res.Priority == "" {
+		res.Priority = "none"
+	}
+	return res
+}
+
+func emptyToNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// QuickAddTask @Summary Quick Add Task
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description create task from a single line like "Pay rent tomorrow 9am #Finance !high @bills".
+// @Description #category and @tag are resolved by title, !priority is a priority name. Dates: today, tomorrow,
+// @Description a weekday name, next week, in N days/weeks, YYYY-MM-DD. Times: 9am, 9:30pm, 21:00, noon, midnight.
+// @Description The rest of the line is the title. With dry_run the parsed fields are returned and nothing is saved
+// @ModuleID quickAddTask
+// @Accept  json
+// @Produce  json
+// @Param input body quickAddTaskInput true "quick-add line"
+// @Success 200 {object} quickAddPreviewResponse "dry run"
+// @Success 201 {object} taskResponse
+// @Failure 400,401,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/quick [post]
+func (h *Handler) QuickAddTask(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp quickAddTaskInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.services.Tasks.QuickAdd(c, service.QuickAddTaskInput{
+		UserID:     userID,
+		Text:       inp.Text,
+		CategoryID: inp.CategoryID,
+		Timezone:   inp.Timezone,
+		DryRun:     inp.DryRun,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrQuickAddTitleRequired), errors.Is(err, customErrors.ErrQuickAddCategoryRequired),
+			errors.Is(err, customErrors.ErrCategoryNotFound), errors.Is(err, customErrors.ErrTagNotFound),
+			errors.Is(err, customErrors.ErrDueDateRequired):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrTaskAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	if result.Task == nil {
+		c.JSON(http.StatusOK, toQuickAddPreviewResponse(result))
+		return
+	}
+	c.JSON(http.StatusCreated, toTaskResponse(*result.Task))
+}
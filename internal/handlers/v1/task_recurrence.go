@@ -0,0 +1,221 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskRecurrenceRoutes(tasks *gin.RouterGroup) {
+	tasks.PUT("/:id/recurrence", h.SetTaskRecurrence)
+	tasks.DELETE("/:id/recurrence", h.StopTaskRecurrence)
+	tasks.GET("/:id/occurrences", h.GetTaskOccurrences)
+}
+
+// recurrenceInput weekdays are used only with the weekly frequency and month_day only with the monthly one.
+type recurrenceInput struct {
+	Frequency string   `json:"frequency" binding:"required,oneof=daily weekly monthly after_completion"`
+	Interval  int      `json:"interval" binding:"omitempty,min=1,max=365"`
+	Weekdays  []string `json:"weekdays" binding:"required_if=Frequency weekly,excluded_unless=Frequency weekly,dive,oneof=mo tu we th fr sa su"`
+	MonthDay  int      `json:"month_day" binding:"required_if=Frequency monthly,excluded_unless=Frequency monthly,min=0,max=31"`
+}
+
+type getTaskOccurrencesQuery struct {
+	Count int `form:"count" binding:"omitempty,min=1,max=50"`
+}
+
+type recurrenceResponse struct {
+	Frequency string   `json:"frequency"`
+	Interval  int      `json:"interval"`
+	Weekdays  []string `json:"weekdays,omitempty"`
+	MonthDay  int      `json:"month_day,omitempty"`
+}
+
+type occurrenceResponse struct {
+	DueDate     string     `json:"due_date"`
+	DueTime     *string    `json:"due_time"`
+	DueTimezone *string    `json:"due_timezone"`
+	DueAt       *time.Time `json:"due_at"`
+}
+
+func (inp recurrenceInput) toRecurrence() (domain.Recurrence, error) {
+	return domain.NewRecurrence(inp.Frequency, inp.Interval, inp.Weekdays, inp.MonthDay)
+}
+
+// toRecurrenceResponse returns nil for tasks that don't carry a recurrence rule.
+func toRecurrenceResponse(rule *string) *recurrenceResponse {
+	if rule == nil {
+		return nil
+	}
+
+	recurrence, err := domain.ParseRecurrence(*rule)
+	if err != nil {
+		return nil
+	}
+
+	return &recurrenceResponse{
+		Frequency: string(recurrence.Frequency),
+		Interval:  recurrence.Interval,
+		Weekdays:  recurrence.WeekdayNames(),
+		MonthDay:  recurrence.MonthDay,
+	}
+}
+
+// SetTaskRecurrence @Summary Set Task Recurrence
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description set or edit the recurrence of the task series, the series continues from its latest occurrence.
+// @Description Completing a recurring task creates its next occurrence with shifted due date
+// @ModuleID setTaskRecurrence
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body recurrenceInput true "recurrence info"
+// @Success 200 {object} taskResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/recurrence [put]
+func (h *Handler) SetTaskRecurrence(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp recurrenceInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	recurrence, err := inp.toRecurrence()
+	if err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.SetRecurrence(c, taskID, userID, recurrence)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrRecurrenceDueDate):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+// StopTaskRecurrence @Summary Stop Task Recurrence
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description stop the task series, existing occurrences are kept
+// @ModuleID stopTaskRecurrence
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {object} taskResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/recurrence [delete]
+func (h *Handler) StopTaskRecurrence(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.StopRecurrence(c, taskID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTaskNotRecurring):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+// GetTaskOccurrences @Summary Get Task Occurrences
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description preview due dates of upcoming occurrences of the task series,
+// @Description tasks recurring after completion are expected to be completed when they are due
+// @ModuleID getTaskOccurrences
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param count query int false "number of occurrences" default(5)
+// @Success 200 {array} occurrenceResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/occurrences [get]
+func (h *Handler) GetTaskOccurrences(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var query getTaskOccurrencesQuery
+	if err := c.BindQuery(&query); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, query)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if query.Count == 0 {
+		query.Count = 5
+	}
+
+	occurrences, err := h.services.Tasks.GetOccurrences(c, taskID, userID, query.Count)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTaskNotRecurring):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	occurrencesList := make([]occurrenceResponse, len(occurrences))
+	for i, due := range occurrences {
+		occurrencesList[i] = occurrenceResponse{
+			DueDate:     due.Date.Format(time.DateOnly),
+			DueTime:     due.Time,
+			DueTimezone: due.Timezone,
+			DueAt:       due.At,
+		}
+	}
+
+	c.JSON(http.StatusOK, occurrencesList)
+}
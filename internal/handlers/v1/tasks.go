@@ -8,6 +8,7 @@ import (
 	"todo_list_go/internal/domain"
 	"todo_list_go/internal/service"
 	customErrors "todo_list_go/pkg/errors"
+	"todo_list_go/pkg/markdown"
 )
 
 func (h *Handler) initTasksRoutes(api *gin.RouterGroup) {
@@ -19,21 +20,55 @@ func (h *Handler) initTasksRoutes(api *gin.RouterGroup) {
 		tasks.GET("/:id", h.GetTaskById)
 		tasks.PUT("/:id", h.UpdateTask)
 		tasks.DELETE("/:id", h.DeleteTask)
+		tasks.POST("/:id/restore", h.RestoreTask)
+		h.initChecklistItemsRoutes(tasks)
+		h.initTaskRecurrenceRoutes(tasks)
+		h.initTaskArchiveRoutes(tasks)
+		h.initTaskBulkRoutes(tasks)
+		h.initTaskQuickAddRoutes(tasks)
+		h.initTaskPositionRoutes(tasks)
+		h.initTaskDependencyRoutes(tasks)
+		h.initTaskTimeEntriesRoutes(tasks)
+		h.initCommentsRoutes(tasks)
+		h.initAttachmentsRoutes(tasks)
+		h.initTaskHistoryRoutes(tasks)
 	}
 }
 
 type createTaskInput struct {
-	CategoryID  string `json:"category_id" binding:"required,uuid"`
-	Title       string `json:"title" binding:"required,min=1,max=255"`
-	Description string `json:"description" binding:"min=0,max=255"`
+	CategoryID string `json:"category_id" binding:"required,uuid"`
+	Title      string `json:"title" binding:"required,min=1,max=255"`
+	// Description is Markdown, its length limit is set in the config
+	Description string `json:"description"`
 	Completed   bool   `json:"completed"`
+	// Status takes precedence over completed, a completed task without status is done
+	Status          string `json:"status" binding:"omitempty,oneof=todo in_progress blocked done"`
+	Priority        string `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueDate         string `json:"due_date" binding:"omitempty,datetime=2006-01-02"`
+	DueTime         string `json:"due_time" binding:"omitempty,datetime=15:04"`
+	DueTimezone     string `json:"due_timezone" binding:"omitempty,timezone"`
+	EstimateMinutes int    `json:"estimate_minutes" binding:"omitempty,min=1,max=100000"`
+	// Recurrence makes the task recurring, it requires due_date
+	Recurrence *recurrenceInput `json:"recurrence" binding:"omitempty"`
+	TagIDs     []string         `json:"tag_ids" binding:"omitempty,max=20,dive,uuid"`
 }
 
+// updateTaskInput due fields accept an empty string to clear the stored value.
+// Status takes precedence over completed, uncompleting a done task moves it back to todo.
 type updateTaskInput struct {
 	CategoryID  *string `json:"category_id" binding:"omitempty,uuid"`
 	Title       *string `json:"title" binding:"omitempty,min=1,max=255"`
-	Description *string `json:"description" binding:"omitempty,min=0,max=255"`
+	Description *string `json:"description"`
 	Completed   *bool   `json:"completed" binding:"omitempty"`
+	Status      *string `json:"status" binding:"omitempty,oneof=todo in_progress blocked done"`
+	Priority    *string `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueDate     *string `json:"due_date" binding:"omitempty,eq=|datetime=2006-01-02"`
+	DueTime     *string `json:"due_time" binding:"omitempty,eq=|datetime=15:04"`
+	DueTimezone *string `json:"due_timezone" binding:"omitempty,eq=|timezone"`
+	// EstimateMinutes set to 0 clears the estimate
+	EstimateMinutes *int `json:"estimate_minutes" binding:"omitempty,min=0,max=100000"`
+	// TagIDs replace tags of the task, an empty list removes all tags
+	TagIDs []string `json:"tag_ids" binding:"omitempty,max=20,dive,uuid"`
 }
 
 type taskResponse struct {
@@ -43,7 +78,126 @@ type taskResponse struct {
 	Category    categoryResponse `json:"category"`
 	Title       string           `json:"title"`
 	Description string           `json:"description"`
-	Completed   bool             `json:"completed"`
+	// DescriptionHTML is the description rendered from Markdown and sanitized, it's returned with format=html
+	DescriptionHTML *string                   `json:"description_html,omitempty"`
+	Completed       bool                      `json:"completed"`
+	Status          string                    `json:"status"`
+	Priority        string                    `json:"priority"`
+	DueDate         *string                   `json:"due_date"`
+	DueTime         *string                   `json:"due_time"`
+	DueTimezone     *string                   `json:"due_timezone"`
+	DueAt           *time.Time                `json:"due_at"`
+	Checklist       checklistProgressResponse `json:"checklist"`
+	// EstimateMinutes is null for tasks without an estimate,
+	// TrackedMinutes sums time entries of the task including the running timer
+	EstimateMinutes *int `json:"estimate_minutes"`
+	TrackedMinutes  int  `json:"tracked_minutes"`
+	CommentsCount   int  `json:"comments_count"`
+	// Recurrence is set on the latest occurrence of a recurring task only
+	Recurrence *recurrenceResponse `json:"recurrence"`
+	SeriesID   *string             `json:"series_id"`
+	Tags       []tagResponse       `json:"tags"`
+	// BlockedBy lists ids of the tasks blocking the task and Blocks ids of the tasks it blocks
+	BlockedBy   []string             `json:"blocked_by"`
+	Blocks      []string             `json:"blocks"`
+	Attachments []attachmentResponse `json:"attachments"`
+	Position    string               `json:"position"`
+	ArchivedAt  *time.Time           `json:"archived_at"`
+	// DeletedAt is returned for tasks in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Highlight is returned for search results when highlighting is requested
+	Highlight *taskHighlightResponse `json:"highlight,omitempty"`
+}
+
+// taskHighlightResponse texts are HTML-escaped with the matches wrapped in <mark>.
+type taskHighlightResponse struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func toTaskResponse(task service.TaskOutput) taskResponse {
+	var dueDate *string
+	if task.DueDate != nil {
+		formatted := task.DueDate.Format(time.DateOnly)
+		dueDate = &formatted
+	}
+
+	var highlight *taskHighlightResponse
+	if task.TitleHighlight != nil && task.DescriptionHighlight != nil {
+		highlight = &taskHighlightResponse{
+			Title:       *task.TitleHighlight,
+			Description: *task.DescriptionHighlight,
+		}
+	}
+
+	return taskResponse{
+		ID:              task.ID,
+		CreatedAt:       task.CreatedAt,
+		UpdatedAt:       task.UpdatedAt,
+		Category:        toCategoryResponse(task.Category),
+		Title:           task.Title,
+		Description:     task.Description,
+		Completed:       task.Completed,
+		Status:          task.Status.String(),
+		Priority:        task.Priority.String(),
+		DueDate:         dueDate,
+		DueTime:         task.DueTime,
+		DueTimezone:     task.DueTimezone,
+		DueAt:           task.DueAt,
+		Checklist:       checklistProgressResponse{Done: task.ChecklistDone, Total: task.ChecklistTotal},
+		EstimateMinutes: task.EstimateMinutes,
+		TrackedMinutes:  task.TrackedMinutes,
+		CommentsCount:   task.CommentsCount,
+		Recurrence:      toRecurrenceResponse(task.RecurrenceRule),
+		SeriesID:        task.SeriesID,
+		Tags:            toTagsResponse(task.Tags),
+		BlockedBy:       nonNilIDs(task.BlockedBy),
+		Blocks:          nonNilIDs(task.Blocks),
+		Attachments:     toAttachmentsResponse(task.Attachments),
+		Position:        task.Position,
+		ArchivedAt:      task.ArchivedAt,
+		DeletedAt:       task.DeletedAt,
+		Highlight:       highlight,
+	}
+}
+
+// taskFormatQuery Format html adds the description rendered from Markdown to task responses.
+type taskFormatQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=markdown html"`
+}
+
+const taskFormatHTML = "html"
+
+// bindTaskFormat writes the error response when the format is not valid.
+func bindTaskFormat(c *gin.Context) (taskFormatQuery, bool) {
+	var query taskFormatQuery
+	if err := c.BindQuery(&query); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, query)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return taskFormatQuery{}, false
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return taskFormatQuery{}, false
+	}
+	return query, true
+}
+
+func (q taskFormatQuery) taskResponse(task service.TaskOutput) taskResponse {
+	res := toTaskResponse(task)
+	if q.Format == taskFormatHTML {
+		descriptionHTML := markdown.ToHTML(task.Description)
+		res.DescriptionHTML = &descriptionHTML
+	}
+	return res
+}
+
+// nonNilIDs keeps empty id lists serialized as [] rather than null.
+func nonNilIDs(ids []string) []string {
+	if ids == nil {
+		return make([]string, 0)
+	}
+	return ids
 }
 
 func toCategoryResponse(category domain.Category) categoryResponse {
@@ -53,6 +207,7 @@ func toCategoryResponse(category domain.Category) categoryResponse {
 		Title:       category.Title,
 		Description: category.Description,
 		Color:       category.Color,
+		DeletedAt:   category.DeletedAt,
 	}
 }
 
@@ -65,12 +220,31 @@ func toCategoryResponse(category domain.Category) categoryResponse {
 // @Produce  json
 // @Param page query int false "page number" default(1)
 // @Param limit query int false "items per page" default(20)
+// @Param cursor query string false "Opaque cursor from next_cursor, pass an empty value to get the first page in cursor mode. Page is ignored and totals are not returned in cursor mode"
 // @Param completed query bool false "completed (true/false)"
-// @Param createdAtDateFrom query string false "format: yyyy-mm-dd"
-// @Param createdAtDateTo query string false "format: yyyy-mm-dd"
+// @Param archived query bool false "return archived tasks instead of active ones (true/false)" default(false)
+// @Param createdAtDateFrom query string false "format: yyyy-mm-dd, the whole day is included"
+// @Param createdAtDateTo query string false "format: yyyy-mm-dd, the whole day is included"
+// @Param updatedAtDateFrom query string false "format: yyyy-mm-dd, the whole day is included"
+// @Param updatedAtDateTo query string false "format: yyyy-mm-dd, the whole day is included"
+// @Param timezone query string false "IANA time zone days of the created and updated date filters are taken in" default(UTC)
 // @Param categoryIds query string false "Comma-separated list of category IDs (e.g. uuid1,uuid2)"
+// @Param dueDateFrom query string false "format: yyyy-mm-dd"
+// @Param dueDateTo query string false "format: yyyy-mm-dd"
+// @Param overdue query bool false "overdue (true/false)"
+// @Param blocked query bool false "tasks blocked by open tasks when true, tasks without open blockers when false"
+// @Param priorities query string false "Comma-separated list of priorities (none, low, medium, high, urgent)"
+// @Param status query string false "Comma-separated list of statuses (todo, in_progress, blocked, done)"
+// @Param tagIds query string false "Comma-separated list of tag IDs"
+// @Param tags query string false "Comma-separated list of tag titles"
+// @Param tagMatch query string false "Match tasks having any (default) or all of the requested tags" Enums(any, all)
+// @Param q query string false "Full-text search over titles and descriptions, supports quoted phrases, OR and -word"
+// @Param highlight query bool false "Return highlighted search matches, used with q"
+// @Param filter query string false "Filter expression, e.g. (category:Work OR category:Home) AND NOT completed AND created>2025-01-01. Fields: category, tag, title, status, priority, completed, overdue, blocked, created, updated, due"
+// @Param format query string false "markdown (default) or html to add description_html rendered from the Markdown description" Enums(markdown, html)
+// @Param sort query string false "Comma-separated list of fields: created_at, updated_at, title, priority, due_date, category_title, relevance (only with q), position (manual order set with the move endpoint). Prefix a field with - for descending order (e.g. title,-created_at). priority puts the most important tasks first, then the ones due sooner and the newest ones, -priority puts the least important first. Default: -relevance with q, -created_at otherwise"
 // @Success 200 {array} taskResponse
-// @Failure 401 {object} errorResponse
+// @Failure 400,401 {object} errorResponse
 // @Failure 500 {object} errorResponse
 // @Failure default {object} errorResponse
 // @Router /tasks [get]
@@ -92,35 +266,40 @@ func (h *Handler) GetAllTasks(c *gin.Context) {
 		return
 	}
 
+	format, ok := bindTaskFormat(c)
+	if !ok {
+		return
+	}
+
 	query.NormalizePagination()
 	query.NormalizeFilters()
 
+	if query.Q == "" && query.SortsBy(domain.TaskSortRelevance) {
+		newErrorResponse(c, http.StatusBadRequest, map[string]string{"sort": "relevance can be used only with q"})
+		return
+	}
+
 	res, err := h.services.Tasks.GetList(c, userID, query)
 	if err != nil {
+		if errors.Is(err, customErrors.ErrInvalidCursor) || errors.Is(err, customErrors.ErrInvalidTaskFilter) {
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	tasksList := make([]taskResponse, len(res.Items))
 	for i, task := range res.Items {
-		tasksList[i] = taskResponse{
-			ID:          task.ID,
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
-			Category:    toCategoryResponse(task.Category),
-			Title:       task.Title,
-			Description: task.Description,
-			Completed:   task.Completed,
-		}
+		tasksList[i] = format.taskResponse(task)
 	}
 
-	c.JSON(http.StatusOK, paginatedResponse[taskResponse]{
-		Page:       query.Page,
-		Limit:      query.Limit,
-		TotalPages: res.TotalPages,
-		Total:      res.TotalItems,
-		Items:      tasksList,
-	})
+	if query.CursorMode() {
+		c.JSON(http.StatusOK, newCursorPaginatedResponse(*query.Cursor, res.NextCursor, query.Limit, tasksList))
+		return
+	}
+
+	c.JSON(http.StatusOK, newPagePaginatedResponse(query.Page, query.Limit, res.TotalPages, res.TotalItems, tasksList))
 }
 
 // CreateTask @Summary Create Task
@@ -131,6 +310,7 @@ func (h *Handler) GetAllTasks(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param input body createTaskInput true "task info"
+// @Param format query string false "markdown (default) or html to add description_html rendered from the Markdown description" Enums(markdown, html)
 // @Success 201 {object} taskResponse
 // @Failure 400,401,409 {object} errorResponse
 // @Failure 500 {object} errorResponse
@@ -143,6 +323,11 @@ func (h *Handler) CreateTask(c *gin.Context) {
 		return
 	}
 
+	format, ok := bindTaskFormat(c)
+	if !ok {
+		return
+	}
+
 	var inp createTaskInput
 	if err := c.BindJSON(&inp); err != nil {
 		out := customErrors.FormatValidationErrorOutput(err, inp)
@@ -154,17 +339,37 @@ func (h *Handler) CreateTask(c *gin.Context) {
 		return
 	}
 
+	var recurrence *domain.Recurrence
+	if inp.Recurrence != nil {
+		taskRecurrence, err := inp.Recurrence.toRecurrence()
+		if err != nil {
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		recurrence = &taskRecurrence
+	}
+
 	task, err := h.services.Tasks.Create(c, service.CreateTaskInput{
-		UserID:      userID,
-		CategoryID:  inp.CategoryID,
-		Title:       inp.Title,
-		Description: inp.Description,
-		Completed:   inp.Completed,
+		UserID:          userID,
+		CategoryID:      inp.CategoryID,
+		Title:           inp.Title,
+		Description:     inp.Description,
+		Completed:       inp.Completed,
+		Status:          inp.Status,
+		Priority:        inp.Priority,
+		DueDate:         inp.DueDate,
+		DueTime:         inp.DueTime,
+		DueTimezone:     inp.DueTimezone,
+		EstimateMinutes: inp.EstimateMinutes,
+		Recurrence:      recurrence,
+		TagIDs:          inp.TagIDs,
 	})
 
 	if err != nil {
 		switch {
-		case errors.Is(err, customErrors.ErrCategoryNotFound):
+		case errors.Is(err, customErrors.ErrCategoryNotFound), errors.Is(err, customErrors.ErrTagNotFound),
+			errors.Is(err, customErrors.ErrDueDateRequired), errors.Is(err, customErrors.ErrRecurrenceDueDate),
+			errors.Is(err, customErrors.ErrTaskDescriptionTooLong):
 			newErrorResponse(c, http.StatusBadRequest, err.Error())
 		case errors.Is(err, customErrors.ErrTaskAlreadyExists):
 			newErrorResponse(c, http.StatusConflict, err.Error())
@@ -174,18 +379,7 @@ func (h *Handler) CreateTask(c *gin.Context) {
 		return
 	}
 
-	c.JSON(
-		http.StatusCreated,
-		taskResponse{
-			ID:          task.ID,
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
-			Category:    toCategoryResponse(task.Category),
-			Title:       task.Title,
-			Description: task.Description,
-			Completed:   task.Completed,
-		},
-	)
+	c.JSON(http.StatusCreated, format.taskResponse(task))
 }
 
 // GetTaskById @Summary Get Task
@@ -196,8 +390,9 @@ func (h *Handler) CreateTask(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Param id path string true "task id"
+// @Param format query string false "markdown (default) or html to add description_html rendered from the Markdown description" Enums(markdown, html)
 // @Success 200 {object} taskResponse
-// @Failure 404 {object} errorResponse
+// @Failure 400,404 {object} errorResponse
 // @Failure 500 {object} errorResponse
 // @Failure default {object} errorResponse
 // @Router /tasks/{id} [get]
@@ -209,6 +404,11 @@ func (h *Handler) GetTaskById(c *gin.Context) {
 		return
 	}
 
+	format, ok := bindTaskFormat(c)
+	if !ok {
+		return
+	}
+
 	task, err := h.services.Tasks.GetByID(c, taskId, userID)
 	if err != nil {
 		if errors.Is(err, customErrors.ErrTaskNotFound) {
@@ -218,29 +418,21 @@ func (h *Handler) GetTaskById(c *gin.Context) {
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
-	c.JSON(
-		http.StatusOK,
-		taskResponse{
-			ID:          task.ID,
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
-			Category:    toCategoryResponse(task.Category),
-			Title:       task.Title,
-			Description: task.Description,
-			Completed:   task.Completed,
-		},
-	)
+	c.JSON(http.StatusOK, format.taskResponse(task))
 }
 
 // UpdateTask @Summary Update Task
 // @Security ApiKeyAuth
 // @Tags tasks
-// @Description update task
+// @Description update task, a task can't be completed while its blockers are open
 // @ModuleID updateTask
 // @Accept  json
 // @Param id path string true "task id"
 // @Param input body updateTaskInput true "update task info"
+// @Param format query string false "markdown (default) or html to add description_html rendered from the Markdown description" Enums(markdown, html)
 // @Success 200 {object} taskResponse
+// @Header 200 {string} X-Undo-Token "token to undo the update"
+// @Header 200 {string} X-Undo-Expires-At "expiration time of the undo token"
 // @Failure 400,401,404,409 {object} errorResponse
 // @Failure 500 {object} errorResponse
 // @Failure default {object} errorResponse
@@ -253,6 +445,11 @@ func (h *Handler) UpdateTask(c *gin.Context) {
 		return
 	}
 
+	format, ok := bindTaskFormat(c)
+	if !ok {
+		return
+	}
+
 	var inp updateTaskInput
 	if err := c.BindJSON(&inp); err != nil {
 		out := customErrors.FormatValidationErrorOutput(err, inp)
@@ -264,23 +461,33 @@ func (h *Handler) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	task, err := h.services.Tasks.Update(
+	task, undoToken, err := h.services.Tasks.Update(
 		c,
 		service.UpdateTaskInput{
-			ID:          taskID,
-			UserID:      userID,
-			CategoryID:  inp.CategoryID,
-			Title:       inp.Title,
-			Description: inp.Description,
-			Completed:   inp.Completed,
+			ID:              taskID,
+			UserID:          userID,
+			CategoryID:      inp.CategoryID,
+			Title:           inp.Title,
+			Description:     inp.Description,
+			Completed:       inp.Completed,
+			Status:          inp.Status,
+			Priority:        inp.Priority,
+			DueDate:         inp.DueDate,
+			DueTime:         inp.DueTime,
+			DueTimezone:     inp.DueTimezone,
+			EstimateMinutes: inp.EstimateMinutes,
+			TagIDs:          inp.TagIDs,
 		})
 	if err != nil {
 		switch {
 		case errors.Is(err, customErrors.ErrTaskNotFound):
 			newErrorResponse(c, http.StatusNotFound, err.Error())
-		case errors.Is(err, customErrors.ErrCategoryNotFound):
+		case errors.Is(err, customErrors.ErrCategoryNotFound), errors.Is(err, customErrors.ErrTagNotFound),
+			errors.Is(err, customErrors.ErrDueDateRequired), errors.Is(err, customErrors.ErrRecurrenceDueDate),
+			errors.Is(err, customErrors.ErrTaskDescriptionTooLong):
 			newErrorResponse(c, http.StatusBadRequest, err.Error())
-		case errors.Is(err, customErrors.ErrTaskAlreadyExists):
+		case errors.Is(err, customErrors.ErrTaskAlreadyExists), errors.Is(err, customErrors.ErrTaskStatusTransition),
+			errors.Is(err, customErrors.ErrTaskBlocked):
 			newErrorResponse(c, http.StatusConflict, err.Error())
 		case errors.Is(err, customErrors.ErrNoUpdateFields):
 			newErrorResponse(c, http.StatusBadRequest, err.Error())
@@ -290,30 +497,21 @@ func (h *Handler) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	c.JSON(
-		http.StatusOK,
-		taskResponse{
-			ID:          task.ID,
-			CreatedAt:   task.CreatedAt,
-			UpdatedAt:   task.UpdatedAt,
-			Category:    toCategoryResponse(task.Category),
-			Title:       task.Title,
-			Description: task.Description,
-			Completed:   task.Completed,
-		},
-	)
-
+	setUndoHeaders(c, undoToken)
+	c.JSON(http.StatusOK, format.taskResponse(task))
 }
 
 // DeleteTask @Summary Delete Task
 // @Security ApiKeyAuth
 // @Tags tasks
-// @Description delete task
+// @Description move task to the trash
 // @ModuleID deleteTask
 // @Accept  json
 // @Produce  json
 // @Param id path string true "task id"
 // @Success 204
+// @Header 204 {string} X-Undo-Token "token to undo the delete"
+// @Header 204 {string} X-Undo-Expires-At "expiration time of the undo token"
 // @Failure 401,404 {object} errorResponse
 // @Failure 500 {object} errorResponse
 // @Failure default {object} errorResponse
@@ -326,7 +524,7 @@ func (h *Handler) DeleteTask(c *gin.Context) {
 		return
 	}
 
-	err = h.services.Tasks.Delete(c, taskID, userID)
+	undoToken, err := h.services.Tasks.Delete(c, taskID, userID)
 	if err != nil {
 		if errors.Is(err, customErrors.ErrTaskNotFound) {
 			newErrorResponse(c, http.StatusNotFound, err.Error())
@@ -336,5 +534,43 @@ func (h *Handler) DeleteTask(c *gin.Context) {
 		return
 	}
 
+	setUndoHeaders(c, undoToken)
 	c.Status(http.StatusNoContent)
 }
+
+// RestoreTask @Summary Restore Task
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description restore task from the trash, the task category must be restored first
+// @ModuleID restoreTask
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {object} taskResponse
+// @Failure 401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/restore [post]
+func (h *Handler) RestoreTask(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	task, err := h.services.Tasks.Restore(c, taskID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTaskAlreadyExists), errors.Is(err, customErrors.ErrTaskCategoryDeleted):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task))
+}
@@ -0,0 +1,391 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTemplatesRoutes(api *gin.RouterGroup) {
+	templates := api.Group("/templates")
+	{
+		templates.Use(h.UserIdentityMiddleware)
+		templates.GET("", h.GetAllTemplates)
+		templates.POST("", h.CreateTemplate)
+		templates.GET("/:id", h.GetTemplateById)
+		templates.PUT("/:id", h.UpdateTemplate)
+		templates.DELETE("/:id", h.DeleteTemplate)
+		templates.POST("/:id/instantiate", h.InstantiateTemplate)
+	}
+}
+
+// templateTaskInput is due due_offset_days after the start date of the instantiation,
+// titles of the task and its checklist items may contain {{variable}} placeholders.
+type templateTaskInput struct {
+	CategoryID      string   `json:"category_id" binding:"required,uuid"`
+	Title           string   `json:"title" binding:"required,min=1,max=255"`
+	Description     string   `json:"description"`
+	Priority        string   `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueOffsetDays   *int     `json:"due_offset_days" binding:"omitempty,min=0,max=3650"`
+	DueTime         string   `json:"due_time" binding:"omitempty,datetime=15:04"`
+	DueTimezone     string   `json:"due_timezone" binding:"omitempty,timezone"`
+	EstimateMinutes int      `json:"estimate_minutes" binding:"omitempty,min=1,max=100000"`
+	ChecklistItems  []string `json:"checklist_items" binding:"omitempty,max=50,dive,min=1,max=255"`
+}
+
+func (t templateTaskInput) toTemplateTask() domain.TemplateTask {
+	return domain.TemplateTask(t)
+}
+
+type createTemplateInput struct {
+	Title       string              `json:"title" binding:"required,min=1,max=255"`
+	Description string              `json:"description"`
+	Tasks       []templateTaskInput `json:"tasks" binding:"required,min=1,max=100,dive"`
+}
+
+// updateTemplateInput tasks replace all tasks of the template.
+type updateTemplateInput struct {
+	Title       *string             `json:"title" binding:"omitempty,min=1,max=255"`
+	Description *string             `json:"description"`
+	Tasks       []templateTaskInput `json:"tasks" binding:"omitempty,min=1,max=100,dive"`
+}
+
+// instantiateTemplateInput due offsets are counted from start_date, today in UTC by default.
+// Variables give values of the {{variable}} placeholders.
+type instantiateTemplateInput struct {
+	StartDate string            `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	Variables map[string]string `json:"variables" binding:"omitempty,max=50,dive,max=255"`
+}
+
+type templateTaskResponse struct {
+	CategoryID      string   `json:"category_id"`
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	Priority        string   `json:"priority"`
+	DueOffsetDays   *int     `json:"due_offset_days"`
+	DueTime         string   `json:"due_time"`
+	DueTimezone     string   `json:"due_timezone"`
+	EstimateMinutes int      `json:"estimate_minutes"`
+	ChecklistItems  []string `json:"checklist_items"`
+}
+
+type templateResponse struct {
+	ID          string                 `json:"id"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	Tasks       []templateTaskResponse `json:"tasks"`
+}
+
+func toTemplateResponse(template domain.TaskTemplate) templateResponse {
+	tasks := make([]templateTaskResponse, len(template.Tasks))
+	for i, task := range template.Tasks {
+		tasks[i] = templateTaskResponse(task)
+		if tasks[i].ChecklistItems == nil {
+			tasks[i].ChecklistItems = []string{}
+		}
+	}
+
+	return templateResponse{
+		ID:          template.ID,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+		Title:       template.Title,
+		Description: template.Description,
+		Tasks:       tasks,
+	}
+}
+
+func toTemplatesResponse(templates []domain.TaskTemplate) []templateResponse {
+	templatesList := make([]templateResponse, len(templates))
+	for i, template := range templates {
+		templatesList[i] = toTemplateResponse(template)
+	}
+	return templatesList
+}
+
+func toTemplateTasks(inputs []templateTaskInput) []domain.TemplateTask {
+	if inputs == nil {
+		return nil
+	}
+	tasks := make([]domain.TemplateTask, len(inputs))
+	for i, inp := range inputs {
+		tasks[i] = inp.toTemplateTask()
+	}
+	return tasks
+}
+
+// GetAllTemplates @Summary Get Templates
+// @Security ApiKeyAuth
+// @Tags templates
+// @Description get task templates ordered by title
+// @ModuleID getTemplates
+// @Accept  json
+// @Produce  json
+// @Success 200 {array} templateResponse
+// @Failure 401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /templates [get]
+func (h *Handler) GetAllTemplates(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	templates, err := h.services.Templates.GetList(c, userID)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTemplatesResponse(templates))
+}
+
+// CreateTemplate @Summary Create Template
+// @Security ApiKeyAuth
+// @Tags templates
+// @Description create task template, categories of the tasks must exist
+// @ModuleID createTemplate
+// @Accept  json
+// @Produce  json
+// @Param input body createTemplateInput true "template info"
+// @Success 201 {object} templateResponse
+// @Failure 400,401,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /templates [post]
+func (h *Handler) CreateTemplate(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp createTemplateInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	template, err := h.services.Templates.Create(c, service.CreateTemplateInput{
+		UserID:      userID,
+		Title:       inp.Title,
+		Description: inp.Description,
+		Tasks:       toTemplateTasks(inp.Tasks),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrCategoryNotFound), errors.Is(err, customErrors.ErrDueDateRequired):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrTemplateAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTemplateResponse(template))
+}
+
+// GetTemplateById @Summary Get Template By Id
+// @Security ApiKeyAuth
+// @Tags templates
+// @Description get task template by id
+// @ModuleID getTemplateById
+// @Accept  json
+// @Produce  json
+// @Param id path string true "template id"
+// @Success 200 {object} templateResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /templates/{id} [get]
+func (h *Handler) GetTemplateById(c *gin.Context) {
+	templateID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	template, err := h.services.Templates.GetByID(c, templateID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTemplateNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTemplateResponse(template))
+}
+
+// UpdateTemplate @Summary Update Template
+// @Security ApiKeyAuth
+// @Tags templates
+// @Description update task template, tasks replace all tasks of the template
+// @ModuleID updateTemplate
+// @Accept  json
+// @Produce  json
+// @Param id path string true "template id"
+// @Param input body updateTemplateInput true "update template info"
+// @Success 200 {object} templateResponse
+// @Failure 400,401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /templates/{id} [put]
+func (h *Handler) UpdateTemplate(c *gin.Context) {
+	templateID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp updateTemplateInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	template, err := h.services.Templates.Update(c, service.UpdateTemplateInput{
+		ID:          templateID,
+		UserID:      userID,
+		Title:       inp.Title,
+		Description: inp.Description,
+		Tasks:       toTemplateTasks(inp.Tasks),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTemplateNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrCategoryNotFound), errors.Is(err, customErrors.ErrDueDateRequired),
+			errors.Is(err, customErrors.ErrNoUpdateFields):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrTemplateAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toTemplateResponse(template))
+}
+
+// DeleteTemplate @Summary Delete Template
+// @Security ApiKeyAuth
+// @Tags templates
+// @Description delete task template, tasks created from it are kept
+// @ModuleID deleteTemplate
+// @Accept  json
+// @Produce  json
+// @Param id path string true "template id"
+// @Success 204
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /templates/{id} [delete]
+func (h *Handler) DeleteTemplate(c *gin.Context) {
+	templateID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.services.Templates.Delete(c, templateID, userID); err != nil {
+		if errors.Is(err, customErrors.ErrTemplateNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// InstantiateTemplate @Summary Instantiate Template
+// @Security ApiKeyAuth
+// @Tags templates
+// @Description create all tasks of the template in one transaction, {{variable}} placeholders in titles
+// @Description are replaced with the variable values and due dates are counted from start_date,
+// @Description 409 is returned when a task title is taken, e.g. by an earlier instance of the template
+// @ModuleID instantiateTemplate
+// @Accept  json
+// @Produce  json
+// @Param id path string true "template id"
+// @Param input body instantiateTemplateInput true "instantiation info"
+// @Success 201 {array} taskResponse
+// @Failure 400,401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /templates/{id}/instantiate [post]
+func (h *Handler) InstantiateTemplate(c *gin.Context) {
+	templateID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp instantiateTemplateInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tasks, err := h.services.Templates.Instantiate(c, service.InstantiateTemplateInput{
+		ID:        templateID,
+		UserID:    userID,
+		StartDate: inp.StartDate,
+		Variables: inp.Variables,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTemplateNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTemplateVariableMissing), errors.Is(err, customErrors.ErrCategoryNotFound),
+			errors.Is(err, customErrors.ErrDueDateRequired), errors.Is(err, customErrors.ErrTaskDescriptionTooLong),
+			errors.Is(err, customErrors.ErrInvalidTemplateTask):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, customErrors.ErrTaskAlreadyExists):
+			// task titles are unique, so a template without variables can't be instantiated twice
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	res := make([]taskResponse, len(tasks))
+	for i, task := range tasks {
+		res[i] = toTaskResponse(task)
+	}
+	c.JSON(http.StatusCreated, res)
+}
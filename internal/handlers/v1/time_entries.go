@@ -0,0 +1,404 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTaskTimeEntriesRoutes(tasks *gin.RouterGroup) {
+	tasks.POST("/:id/timer/start", h.StartTaskTimer)
+	tasks.POST("/:id/timer/stop", h.StopTaskTimer)
+
+	entries := tasks.Group("/:id/time-entries")
+	{
+		entries.GET("", h.GetAllTimeEntries)
+		entries.POST("", h.CreateTimeEntry)
+		entries.DELETE("/:entry_id", h.DeleteTimeEntry)
+	}
+}
+
+func (h *Handler) initTimeTrackingRoutes(api *gin.RouterGroup) {
+	timeTracking := api.Group("/time-tracking")
+	{
+		timeTracking.Use(h.UserIdentityMiddleware)
+		timeTracking.GET("/timer", h.GetRunningTimer)
+		timeTracking.GET("/report", h.GetTimeReport)
+	}
+}
+
+// createTimeEntryInput logs time spent on the task without a timer.
+type createTimeEntryInput struct {
+	StartedAt time.Time `json:"started_at" binding:"required"`
+	Minutes   int       `json:"minutes" binding:"required,min=1,max=1440"`
+}
+
+// timeReportQuery dates are both included in the report.
+type timeReportQuery struct {
+	DateFrom string `form:"dateFrom" binding:"required,datetime=2006-01-02"`
+	DateTo   string `form:"dateTo" binding:"required,datetime=2006-01-02"`
+	Timezone string `form:"timezone" binding:"omitempty,timezone"`
+}
+
+// timeEntryResponse minutes are null while the timer is running.
+type timeEntryResponse struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	TaskID    string     `json:"task_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Running   bool       `json:"running"`
+	Minutes   *int       `json:"minutes"`
+}
+
+type timeReportResponse struct {
+	DateFrom     string                       `json:"date_from"`
+	DateTo       string                       `json:"date_to"`
+	Timezone     string                       `json:"timezone"`
+	TotalMinutes int64                        `json:"total_minutes"`
+	Categories   []timeReportCategoryResponse `json:"categories"`
+	Days         []timeReportDayResponse      `json:"days"`
+}
+
+type timeReportCategoryResponse struct {
+	CategoryID string `json:"category_id"`
+	Title      string `json:"title"`
+	Minutes    int64  `json:"minutes"`
+}
+
+type timeReportDayResponse struct {
+	Date    string `json:"date"`
+	Minutes int64  `json:"minutes"`
+}
+
+func toTimeEntryResponse(entry domain.TimeEntry) timeEntryResponse {
+	var minutes *int
+	if entry.EndedAt != nil {
+		entryMinutes := int(entry.EndedAt.Sub(entry.StartedAt).Minutes())
+		minutes = &entryMinutes
+	}
+
+	return timeEntryResponse{
+		ID:        entry.ID,
+		CreatedAt: entry.CreatedAt,
+		TaskID:    entry.TaskID,
+		StartedAt: entry.StartedAt,
+		EndedAt:   entry.EndedAt,
+		Running:   entry.Running(),
+		Minutes:   minutes,
+	}
+}
+
+func toTimeReportResponse(query timeReportQuery, report domain.TimeReport) timeReportResponse {
+	res := timeReportResponse{
+		DateFrom:   query.DateFrom,
+		DateTo:     query.DateTo,
+		Timezone:   query.Timezone,
+		Categories: make([]timeReportCategoryResponse, len(report.Categories)),
+		Days:       make([]timeReportDayResponse, len(report.Days)),
+	}
+	if res.Timezone == "" {
+		res.Timezone = time.UTC.String()
+	}
+
+	for i, category := range report.Categories {
+		res.Categories[i] = timeReportCategoryResponse{
+			CategoryID: category.CategoryID,
+			Title:      category.Title,
+			Minutes:    category.Seconds / 60,
+		}
+	}
+	var totalSeconds int64
+	for i, day := range report.Days {
+		res.Days[i] = timeReportDayResponse{Date: day.Date.Format(time.DateOnly), Minutes: day.Seconds / 60}
+		totalSeconds += day.Seconds
+	}
+	res.TotalMinutes = totalSeconds / 60
+
+	return res
+}
+
+// StartTaskTimer @Summary Start Task Timer
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description start a timer on the task, a user can have only one running timer
+// @ModuleID startTaskTimer
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 201 {object} timeEntryResponse
+// @Failure 401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/timer/start [post]
+func (h *Handler) StartTaskTimer(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entry, err := h.services.TimeEntries.StartTimer(c, taskID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrTaskNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrTimerAlreadyRunning):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTimeEntryResponse(entry))
+}
+
+// StopTaskTimer @Summary Stop Task Timer
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description stop the running timer of the task
+// @ModuleID stopTaskTimer
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {object} timeEntryResponse
+// @Failure 401,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/timer/stop [post]
+func (h *Handler) StopTaskTimer(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entry, err := h.services.TimeEntries.StopTimer(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTimerNotRunning) {
+			newErrorResponse(c, http.StatusConflict, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTimeEntryResponse(entry))
+}
+
+// GetAllTimeEntries @Summary Get Time Entries
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description get time entries of the task, latest entries go first
+// @ModuleID getTimeEntries
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Success 200 {array} timeEntryResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/time-entries [get]
+func (h *Handler) GetAllTimeEntries(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entries, err := h.services.TimeEntries.GetList(c, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entriesList := make([]timeEntryResponse, len(entries))
+	for i, entry := range entries {
+		entriesList[i] = toTimeEntryResponse(entry)
+	}
+
+	c.JSON(http.StatusOK, entriesList)
+}
+
+// CreateTimeEntry @Summary Create Time Entry
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description log time spent on the task without a timer
+// @ModuleID createTimeEntry
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param input body createTimeEntryInput true "time entry info"
+// @Success 201 {object} timeEntryResponse
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/time-entries [post]
+func (h *Handler) CreateTimeEntry(c *gin.Context) {
+	taskID := c.Param("id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var inp createTimeEntryInput
+	if err := c.BindJSON(&inp); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, inp)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.services.TimeEntries.Create(c, service.CreateTimeEntryInput{
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: inp.StartedAt,
+		Minutes:   inp.Minutes,
+	})
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTimeEntryResponse(entry))
+}
+
+// DeleteTimeEntry @Summary Delete Time Entry
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description delete time entry, deleting a running timer stops it without logging the time
+// @ModuleID deleteTimeEntry
+// @Accept  json
+// @Produce  json
+// @Param id path string true "task id"
+// @Param entry_id path string true "time entry id"
+// @Success 204
+// @Failure 400,401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /tasks/{id}/time-entries/{entry_id} [delete]
+func (h *Handler) DeleteTimeEntry(c *gin.Context) {
+	taskID := c.Param("id")
+	entryID := c.Param("entry_id")
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	err = h.services.TimeEntries.Delete(c, entryID, taskID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) || errors.Is(err, customErrors.ErrTimeEntryNotFound) {
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetRunningTimer @Summary Get Running Timer
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description get the running timer of the user
+// @ModuleID getRunningTimer
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} timeEntryResponse
+// @Failure 401,404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /time-tracking/timer [get]
+func (h *Handler) GetRunningTimer(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entry, err := h.services.TimeEntries.GetRunningTimer(c, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTimerNotRunning) {
+			newErrorResponse(c, http.StatusNotFound, "no timer is running")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTimeEntryResponse(entry))
+}
+
+// GetTimeReport @Summary Get Time Report
+// @Security ApiKeyAuth
+// @Tags time tracking
+// @Description sum tracked time by category and by day, an entry counts in full on the day it has started.
+// @Description Running timers are counted up to now
+// @ModuleID getTimeReport
+// @Accept  json
+// @Produce  json
+// @Param dateFrom query string true "format: yyyy-mm-dd"
+// @Param dateTo query string true "format: yyyy-mm-dd, included in the report"
+// @Param timezone query string false "IANA time zone the days are taken in" default(UTC)
+// @Success 200 {object} timeReportResponse
+// @Failure 400,401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /time-tracking/report [get]
+func (h *Handler) GetTimeReport(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var query timeReportQuery
+	if err := c.BindQuery(&query); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, query)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := h.services.TimeEntries.GetReport(c, service.TimeReportInput{
+		UserID:   userID,
+		DateFrom: query.DateFrom,
+		DateTo:   query.DateTo,
+		Timezone: query.Timezone,
+	})
+	if err != nil {
+		if errors.Is(err, customErrors.ErrInvalidDateRange) {
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, toTimeReportResponse(query, report))
+}
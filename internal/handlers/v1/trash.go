@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (h *Handler) initTrashRoutes(api *gin.RouterGroup) {
+	trash := api.Group("/trash")
+	{
+		trash.Use(h.UserIdentityMiddleware)
+		trash.GET("", h.GetTrash)
+	}
+}
+
+// trashResponse tasks and categories are paginated separately with the same page and limit.
+type trashResponse struct {
+	Tasks      paginatedResponse[taskResponse]     `json:"tasks"`
+	Categories paginatedResponse[categoryResponse] `json:"categories"`
+}
+
+// GetTrash @Summary Get Trash
+// @Security ApiKeyAuth
+// @Tags trash
+// @Description get deleted tasks and categories, most recently deleted go first.
+// @Description Items are removed permanently after the retention period. The page and limit apply to tasks
+// @Description and categories separately
+// @ModuleID getTrash
+// @Accept  json
+// @Produce  json
+// @Param page query int false "page number" default(1)
+// @Param limit query int false "items per page" default(20)
+// @Success 200 {object} trashResponse
+// @Failure 400,401 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /trash [get]
+func (h *Handler) GetTrash(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var query domain.PaginationQuery
+	if err := c.BindQuery(&query); err != nil {
+		out := customErrors.FormatValidationErrorOutput(err, query)
+		if out != nil {
+			newErrorResponse(c, http.StatusBadRequest, out)
+			return
+		}
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if query.CursorMode() {
+		newErrorResponse(c, http.StatusBadRequest, map[string]string{"cursor": "is not supported for the trash, use page"})
+		return
+	}
+	query.NormalizePagination()
+
+	tasks, err := h.services.Tasks.GetDeletedList(c, userID, query)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	categories, err := h.services.Categories.GetDeletedList(c, userID, query)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	taskItems := make([]taskResponse, len(tasks.Items))
+	for i, task := range tasks.Items {
+		taskItems[i] = toTaskResponse(task)
+	}
+	categoryItems := make([]categoryResponse, len(categories.Items))
+	for i, category := range categories.Items {
+		categoryItems[i] = toCategoryResponse(category)
+	}
+
+	c.JSON(http.StatusOK, trashResponse{
+		Tasks: newPagePaginatedResponse(query.Page, query.Limit, tasks.TotalPages, tasks.TotalItems, taskItems),
+		Categories: newPagePaginatedResponse(
+			query.Page, query.Limit, categories.TotalPages, categories.TotalItems, categoryItems,
+		),
+	})
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const (
+	undoTokenHeader     = "X-Undo-Token"
+	undoExpiresAtHeader = "X-Undo-Expires-At"
+)
+
+func (h *Handler) initUndoRoutes(api *gin.RouterGroup) {
+	undo := api.Group("/undo")
+	{
+		undo.Use(h.UserIdentityMiddleware)
+		undo.POST("/:token", h.UndoTasks)
+	}
+}
+
+// setUndoHeaders returns the undo token of the operation, operations that changed nothing have no token.
+func setUndoHeaders(c *gin.Context, token service.UndoToken) {
+	if token.Token == "" {
+		return
+	}
+	c.Header(undoTokenHeader, token.Token)
+	c.Header(undoExpiresAtHeader, token.ExpiresAt.UTC().Format(time.RFC3339))
+}
+
+// UndoTasks @Summary Undo Task Operation
+// @Security ApiKeyAuth
+// @Tags tasks
+// @Description revert the update, delete or bulk operation the token was returned for in the X-Undo-Token header.
+// @Description A token can be used once before it expires, the operation can't be undone after its tasks have changed
+// @ModuleID undoTasks
+// @Accept  json
+// @Produce  json
+// @Param token path string true "undo token"
+// @Success 200 {array} taskResponse
+// @Failure 400,401,404,409 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Failure default {object} errorResponse
+// @Router /undo/{token} [post]
+func (h *Handler) UndoTasks(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	tasks, err := h.services.Tasks.Undo(c, c.Param("token"), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, customErrors.ErrUndoTokenNotFound):
+			newErrorResponse(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, customErrors.ErrUndoConflict), errors.Is(err, customErrors.ErrTaskAlreadyExists):
+			newErrorResponse(c, http.StatusConflict, err.Error())
+		case errors.Is(err, customErrors.ErrTaskCategoryDeleted):
+			newErrorResponse(c, http.StatusBadRequest, err.Error())
+		default:
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	res := make([]taskResponse, len(tasks))
+	for i, task := range tasks {
+		res[i] = toTaskResponse(task)
+	}
+	c.JSON(http.StatusOK, res)
+}
@@ -6,10 +6,73 @@ import (
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"io"
+	"slices"
+	"strings"
 	customErrors "todo_list_go/pkg/errors"
 )
 
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = v.RegisterValidation("csv_oneof", validateCSVOneOf)
+		_ = v.RegisterValidation("sort_fields", validateSortFields)
+		_ = v.RegisterValidation("csv_uuid", validateCSVUUID)
+	}
+}
+
+// validateCSVOneOf checks that every item of a comma-separated value is one of the
+// space-separated values from the tag param, e.g. `csv_oneof=low high`.
+func validateCSVOneOf(fl validator.FieldLevel) bool {
+	allowed := strings.Fields(fl.Param())
+	for _, item := range strings.Split(fl.Field().String(), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if !slices.Contains(allowed, item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateCSVUUID checks that every item of a comma-separated value is a UUID.
+func validateCSVUUID(fl validator.FieldLevel) bool {
+	for _, item := range strings.Split(fl.Field().String(), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if uuid.Validate(item) != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateSortFields checks a sort expression like `-updated_at,title`: every field
+// must be one of the space-separated values from the tag param and appear only once.
+func validateSortFields(fl validator.FieldLevel) bool {
+	allowed := strings.Fields(fl.Param())
+	seen := make(map[string]bool)
+	for _, item := range strings.Split(fl.Field().String(), ",") {
+		field := strings.TrimPrefix(strings.TrimSpace(item), "-")
+		if !slices.Contains(allowed, field) || seen[field] {
+			return false
+		}
+		seen[field] = true
+	}
+
+	return true
+}
+
 func BindAndValidateJSON[T any](c *gin.Context, input *T) (bool, any) {
 	var errResponseBody any
 
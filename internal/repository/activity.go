@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
+	"todo_list_go/internal/domain"
+)
+
+const selectActivityQuery = `
+	SELECT a.id, a.created_at, a.user_id, a.task_id, t.title AS task_title, a.action, a.changes
+	FROM task_activities a
+	JOIN tasks t ON t.id = a.task_id`
+
+type ActivityRepo struct {
+	db *sqlx.DB
+}
+
+func NewActivityRepo(db *sqlx.DB) *ActivityRepo {
+	return &ActivityRepo{db: db}
+}
+
+func (r *ActivityRepo) Create(ctx context.Context, activities ...domain.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(activities))
+	args := make([]any, 0, len(activities)*5)
+	for i, activity := range activities {
+		n := len(args)
+		values[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5)
+		args = append(args, activity.CreatedAt, activity.UserID, activity.TaskID, activity.Action, activity.Changes)
+	}
+
+	query := "INSERT INTO task_activities (created_at, user_id, task_id, action, changes) VALUES " +
+		strings.Join(values, ", ") + ";"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
+
+	return err
+}
+
+func (r *ActivityRepo) GetListByTaskID(ctx context.Context, taskID string) ([]domain.Activity, error) {
+	activities := make([]domain.Activity, 0)
+
+	query := selectActivityQuery + " WHERE a.task_id = $1 ORDER BY a.created_at DESC, a.id DESC;"
+	err := conn(ctx, r.db).SelectContext(ctx, &activities, query, taskID)
+
+	return activities, err
+}
+
+func (r *ActivityRepo) GetListByUserID(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) ([]domain.Activity, int64, error) {
+	activities := make([]domain.Activity, 0)
+
+	var count int64
+	err := conn(ctx, r.db).GetContext(ctx, &count, "SELECT COUNT(*) FROM task_activities WHERE user_id = $1;", userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := selectActivityQuery + " WHERE a.user_id = $1 ORDER BY a.created_at DESC, a.id DESC LIMIT $2 OFFSET $3;"
+	err = conn(ctx, r.db).SelectContext(ctx, &activities, listQuery, userID, query.Limit, query.Offset)
+
+	return activities, count, err
+}
+
+func (r *ActivityRepo) GetCursorListByUserID(
+	ctx context.Context, userID string, query domain.PaginationQuery, after *domain.Cursor,
+) ([]domain.Activity, *domain.Cursor, error) {
+	activities := make([]domain.Activity, 0)
+
+	where := "a.user_id = $1"
+	args := []any{userID}
+	if after != nil {
+		where += " AND (a.created_at, a.id) < ($2, $3)"
+		args = append(args, *after.Values[0], after.ID)
+	}
+
+	// fetch one extra activity to find out whether there is a next page
+	listQuery := fmt.Sprintf(
+		"%s WHERE %s ORDER BY a.created_at DESC, a.id DESC LIMIT $%d;", selectActivityQuery, where, len(args)+1,
+	)
+	err := conn(ctx, r.db).SelectContext(ctx, &activities, listQuery, append(args, query.Limit+1)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(activities) <= query.Limit {
+		return activities, nil, nil
+	}
+
+	activities = activities[:query.Limit]
+	lastActivity := activities[len(activities)-1]
+	createdAt := lastActivity.CreatedAt.Format(time.RFC3339Nano)
+	next := &domain.Cursor{
+		Sort:   domain.ActivityFeedSort,
+		Values: []*string{&createdAt},
+		ID:     lastActivity.ID,
+	}
+
+	return activities, next, nil
+}
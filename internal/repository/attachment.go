@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const attachmentColumns = "id, created_at, task_id, user_id, file_name, content_type, size, storage_key"
+
+type AttachmentRepo struct {
+	db *sqlx.DB
+}
+
+func NewAttachmentRepo(db *sqlx.DB) *AttachmentRepo {
+	return &AttachmentRepo{db: db}
+}
+
+func (r *AttachmentRepo) Create(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error) {
+	var createdAttachment domain.Attachment
+
+	query := `
+		INSERT INTO task_attachments (created_at, task_id, user_id, file_name, content_type, size, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + attachmentColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, query, attachment.CreatedAt, attachment.TaskID, attachment.UserID, attachment.FileName,
+		attachment.ContentType, attachment.Size, attachment.StorageKey,
+	).StructScan(&createdAttachment)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	return createdAttachment, nil
+}
+
+func (r *AttachmentRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM task_attachments WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *AttachmentRepo) GetByID(ctx context.Context, attachmentID, taskID string) (domain.Attachment, error) {
+	var attachment domain.Attachment
+
+	query := "SELECT " + attachmentColumns + " FROM task_attachments WHERE id = $1 AND task_id = $2;"
+	err := conn(ctx, r.db).GetContext(ctx, &attachment, query, attachmentID, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Attachment{}, customErrors.ErrAttachmentNotFound
+		}
+		return domain.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (r *AttachmentRepo) GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]domain.Attachment, error) {
+	attachments := make([]domain.Attachment, 0)
+
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM task_attachments
+		WHERE task_id = ANY($1::uuid[])
+		ORDER BY created_at, id;`
+	err := conn(ctx, r.db).SelectContext(ctx, &attachments, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	taskAttachments := make(map[string][]domain.Attachment, len(taskIDs))
+	for _, attachment := range attachments {
+		taskAttachments[attachment.TaskID] = append(taskAttachments[attachment.TaskID], attachment)
+	}
+
+	return taskAttachments, nil
+}
+
+func (r *AttachmentRepo) GetTotalSizeByUserID(ctx context.Context, userID string) (int64, error) {
+	var size int64
+
+	query := "SELECT COALESCE(SUM(size), 0) FROM task_attachments WHERE user_id = $1;"
+	err := conn(ctx, r.db).GetContext(ctx, &size, query, userID)
+
+	return size, err
+}
+
+func (r *AttachmentRepo) GetStorageKeysOfDeletedTasks(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	keys := make([]string, 0)
+
+	query := `
+		SELECT a.storage_key
+		FROM task_attachments a
+		INNER JOIN tasks t ON a.task_id = t.id
+		WHERE t.deleted_at < $1;`
+	err := conn(ctx, r.db).SelectContext(ctx, &keys, query, deletedBefore)
+
+	return keys, err
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	"strings"
+	"time"
 	"todo_list_go/internal/domain"
 	customErrors "todo_list_go/pkg/errors"
 )
@@ -25,7 +26,7 @@ func (r *CategoryRepo) Create(ctx context.Context, category domain.Category) (do
 		INSERT INTO categories (user_id, created_at, title, description, color) 
 		values ($1, $2, $3, $4, $5) 
 		RETURNING id, created_at, title, description, color;`
-	err := r.db.QueryRowxContext(
+	err := conn(ctx, r.db).QueryRowxContext(
 		ctx, query, category.UserID, category.CreatedAt, category.Title, category.Description, category.Color,
 	).StructScan(&createdCategory)
 
@@ -69,7 +70,7 @@ func (r *CategoryRepo) Update(ctx context.Context, inp UpdateCategoryInput) (dom
 	)
 	args = append(args, inp.ID)
 
-	err := r.db.QueryRowxContext(ctx, query, args...).StructScan(&updatedCategory)
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, args...).StructScan(&updatedCategory)
 	if err != nil {
 		if customErrors.IsDuplicateDBError(err) {
 			return domain.Category{}, customErrors.ErrCategoryAlreadyExists
@@ -80,18 +81,63 @@ func (r *CategoryRepo) Update(ctx context.Context, inp UpdateCategoryInput) (dom
 	return updatedCategory, nil
 }
 
-func (r *CategoryRepo) Delete(ctx context.Context, id string) error {
-	query := "DELETE FROM categories WHERE id=$1;"
-	_, err := r.db.ExecContext(ctx, query, id)
+func (r *CategoryRepo) Delete(ctx context.Context, id string, deletedAt time.Time) error {
+	return inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		query := "UPDATE categories SET deleted_at = $1 WHERE id = $2;"
+		if _, err := tx.ExecContext(ctx, query, deletedAt, id); err != nil {
+			return err
+		}
+
+		// tasks share deleted_at with the category, so they can be restored together
+		query = "UPDATE tasks SET deleted_at = $1 WHERE category_id = $2 AND deleted_at IS NULL;"
+		_, err := tx.ExecContext(ctx, query, deletedAt, id)
+
+		return err
+	})
+}
+
+func (r *CategoryRepo) Restore(ctx context.Context, id string) error {
+	return inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		query := `
+			UPDATE tasks SET deleted_at = NULL
+			WHERE category_id = $1 AND deleted_at = (SELECT deleted_at FROM categories WHERE id = $1);`
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			if customErrors.IsDuplicateDBError(err) {
+				return customErrors.ErrTaskAlreadyExists
+			}
+			return err
+		}
+
+		query = "UPDATE categories SET deleted_at = NULL WHERE id = $1;"
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			if customErrors.IsDuplicateDBError(err) {
+				return customErrors.ErrCategoryAlreadyExists
+			}
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *CategoryRepo) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := "DELETE FROM categories WHERE deleted_at < $1;"
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
 
-	return err
+	return res.RowsAffected()
 }
 
 func (r *CategoryRepo) GetListByUserID(ctx context.Context, userID string) ([]domain.Category, error) {
 	categories := make([]domain.Category, 0)
 
-	query := "SELECT id, created_at, title, description, color FROM categories WHERE user_id=$1 ORDER BY created_at DESC;"
-	err := r.db.SelectContext(ctx, &categories, query, userID)
+	query := `
+		SELECT id, created_at, title, description, color FROM categories
+		WHERE user_id=$1 AND deleted_at IS NULL
+		ORDER BY created_at DESC;`
+	err := conn(ctx, r.db).SelectContext(ctx, &categories, query, userID)
 
 	return categories, err
 }
@@ -99,8 +145,30 @@ func (r *CategoryRepo) GetListByUserID(ctx context.Context, userID string) ([]do
 func (r *CategoryRepo) GetByID(ctx context.Context, categoryID, userID string) (domain.Category, error) {
 	var category domain.Category
 
-	query := "SELECT id, created_at, title, description, color FROM categories WHERE id=$1 AND user_id=$2;"
-	err := r.db.GetContext(ctx, &category, query, categoryID, userID)
+	query := `
+		SELECT id, created_at, title, description, color FROM categories
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL;`
+	err := conn(ctx, r.db).GetContext(ctx, &category, query, categoryID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, customErrors.ErrCategoryNotFound
+		}
+
+		return domain.Category{}, err
+	}
+
+	return category, nil
+}
+
+func (r *CategoryRepo) GetByTitle(ctx context.Context, title, userID string) (domain.Category, error) {
+	var category domain.Category
+
+	query := `
+		SELECT id, created_at, title, description, color FROM categories
+		WHERE lower(title) = lower($1) AND user_id = $2 AND deleted_at IS NULL
+		ORDER BY title = $1 DESC
+		LIMIT 1;`
+	err := conn(ctx, r.db).GetContext(ctx, &category, query, title, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Category{}, customErrors.ErrCategoryNotFound
@@ -111,3 +179,42 @@ func (r *CategoryRepo) GetByID(ctx context.Context, categoryID, userID string) (
 
 	return category, nil
 }
+
+func (r *CategoryRepo) GetDeletedByID(ctx context.Context, categoryID, userID string) (domain.Category, error) {
+	var category domain.Category
+
+	query := `
+		SELECT id, created_at, title, description, color, deleted_at FROM categories
+		WHERE id=$1 AND user_id=$2 AND deleted_at IS NOT NULL;`
+	err := conn(ctx, r.db).GetContext(ctx, &category, query, categoryID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, customErrors.ErrCategoryNotFound
+		}
+
+		return domain.Category{}, err
+	}
+
+	return category, nil
+}
+
+func (r *CategoryRepo) GetDeletedListByUserID(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) ([]domain.Category, int64, error) {
+	categories := make([]domain.Category, 0)
+
+	var count int64
+	countQuery := "SELECT COUNT(*) FROM categories WHERE user_id = $1 AND deleted_at IS NOT NULL;"
+	if err := conn(ctx, r.db).GetContext(ctx, &count, countQuery, userID); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := `
+		SELECT id, created_at, title, description, color, deleted_at FROM categories
+		WHERE user_id=$1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id
+		LIMIT $2 OFFSET $3;`
+	err := conn(ctx, r.db).SelectContext(ctx, &categories, listQuery, userID, query.Limit, query.Offset)
+
+	return categories, count, err
+}
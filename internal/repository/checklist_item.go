@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type ChecklistItemRepo struct {
+	db *sqlx.DB
+}
+
+func NewChecklistItemRepo(db *sqlx.DB) *ChecklistItemRepo {
+	return &ChecklistItemRepo{db: db}
+}
+
+func (r *ChecklistItemRepo) Create(ctx context.Context, item domain.ChecklistItem) (domain.ChecklistItem, error) {
+	var createdItem domain.ChecklistItem
+
+	err := inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		// the task row lock keeps concurrent inserts from taking the same position
+		if _, err := tx.ExecContext(ctx, "SELECT id FROM tasks WHERE id = $1 FOR UPDATE;", item.TaskID); err != nil {
+			return err
+		}
+
+		// new items are added to the end of the checklist
+		query := `
+			INSERT INTO checklist_items (created_at, updated_at, task_id, title, completed, position)
+			VALUES (
+				$1, $2, $3, $4, $5,
+				(SELECT COALESCE(MAX(position) + 1, 0) FROM checklist_items WHERE task_id = $3)
+			)
+			RETURNING id, created_at, updated_at, task_id, title, completed, position;`
+		return tx.QueryRowxContext(
+			ctx, query, item.CreatedAt, item.UpdatedAt, item.TaskID, item.Title, item.Completed,
+		).StructScan(&createdItem)
+	})
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	return createdItem, nil
+}
+
+func (r *ChecklistItemRepo) Update(ctx context.Context, inp UpdateChecklistItemInput) (domain.ChecklistItem, error) {
+	var updatedItem domain.ChecklistItem
+
+	setClause := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	setClause = append(setClause, fmt.Sprintf("updated_at = $%d", argID))
+	args = append(args, inp.UpdatedAt)
+	argID++
+	if inp.Title != nil {
+		setClause = append(setClause, fmt.Sprintf("title = $%d", argID))
+		args = append(args, inp.Title)
+		argID++
+	}
+	if inp.Completed != nil {
+		setClause = append(setClause, fmt.Sprintf("completed = $%d", argID))
+		args = append(args, inp.Completed)
+		argID++
+	}
+
+	setQuery := strings.Join(setClause, ", ")
+	query := fmt.Sprintf(
+		`UPDATE checklist_items SET %s WHERE id = $%d
+		RETURNING id, created_at, updated_at, task_id, title, completed, position;`,
+		setQuery, argID,
+	)
+	args = append(args, inp.ID)
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, args...).StructScan(&updatedItem)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	return updatedItem, nil
+}
+
+func (r *ChecklistItemRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM checklist_items WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *ChecklistItemRepo) GetByID(ctx context.Context, itemID, taskID string) (domain.ChecklistItem, error) {
+	var item domain.ChecklistItem
+
+	query := `
+		SELECT id, created_at, updated_at, task_id, title, completed, position
+		FROM checklist_items
+		WHERE id = $1 AND task_id = $2;`
+	err := conn(ctx, r.db).GetContext(ctx, &item, query, itemID, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ChecklistItem{}, customErrors.ErrChecklistItemNotFound
+		}
+
+		return domain.ChecklistItem{}, err
+	}
+
+	return item, nil
+}
+
+func (r *ChecklistItemRepo) GetListByTaskID(ctx context.Context, taskID string) ([]domain.ChecklistItem, error) {
+	items := make([]domain.ChecklistItem, 0)
+
+	query := `
+		SELECT id, created_at, updated_at, task_id, title, completed, position
+		FROM checklist_items
+		WHERE task_id = $1
+		ORDER BY position, created_at;`
+	err := conn(ctx, r.db).SelectContext(ctx, &items, query, taskID)
+
+	return items, err
+}
+
+// Reorder sets item positions to their indexes in itemIDs.
+func (r *ChecklistItemRepo) Reorder(ctx context.Context, taskID string, itemIDs []string) error {
+	query := `
+		UPDATE checklist_items i SET position = ordered.position - 1
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS ordered(id, position)
+		WHERE i.id = ordered.id AND i.task_id = $1;`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, taskID, pq.Array(itemIDs))
+
+	return err
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const commentColumns = "id, created_at, updated_at, task_id, user_id, body"
+
+type CommentRepo struct {
+	db *sqlx.DB
+}
+
+func NewCommentRepo(db *sqlx.DB) *CommentRepo {
+	return &CommentRepo{db: db}
+}
+
+func (r *CommentRepo) Create(ctx context.Context, comment domain.Comment) (domain.Comment, error) {
+	var createdComment domain.Comment
+
+	query := `
+		INSERT INTO task_comments (created_at, updated_at, task_id, user_id, body)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + commentColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, query, comment.CreatedAt, comment.UpdatedAt, comment.TaskID, comment.UserID, comment.Body,
+	).StructScan(&createdComment)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	return createdComment, nil
+}
+
+func (r *CommentRepo) Update(ctx context.Context, inp UpdateCommentInput) (domain.Comment, error) {
+	var updatedComment domain.Comment
+
+	query := "UPDATE task_comments SET updated_at = $1, body = $2 WHERE id = $3 RETURNING " + commentColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, inp.UpdatedAt, inp.Body, inp.ID).StructScan(&updatedComment)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	return updatedComment, nil
+}
+
+func (r *CommentRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM task_comments WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *CommentRepo) GetByID(ctx context.Context, commentID, taskID string) (domain.Comment, error) {
+	var comment domain.Comment
+
+	query := "SELECT " + commentColumns + " FROM task_comments WHERE id = $1 AND task_id = $2;"
+	err := conn(ctx, r.db).GetContext(ctx, &comment, query, commentID, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Comment{}, customErrors.ErrCommentNotFound
+		}
+		return domain.Comment{}, err
+	}
+
+	return comment, nil
+}
+
+func (r *CommentRepo) GetListByTaskID(ctx context.Context, taskID string) ([]domain.Comment, error) {
+	comments := make([]domain.Comment, 0)
+
+	query := "SELECT " + commentColumns + " FROM task_comments WHERE task_id = $1 ORDER BY created_at, id;"
+	err := conn(ctx, r.db).SelectContext(ctx, &comments, query, taskID)
+
+	return comments, err
+}
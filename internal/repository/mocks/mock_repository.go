@@ -12,6 +12,7 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	domain "todo_list_go/internal/domain"
 	repository "todo_list_go/internal/repository"
 
@@ -110,6 +111,50 @@ func (m *MockTaskRepository) EXPECT() *MockTaskRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ArchiveCompleted mocks base method.
+func (m *MockTaskRepository) ArchiveCompleted(ctx context.Context, categoryID string, archivedAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCompleted", ctx, categoryID, archivedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveCompleted indicates an expected call of ArchiveCompleted.
+func (mr *MockTaskRepositoryMockRecorder) ArchiveCompleted(ctx, categoryID, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCompleted", reflect.TypeOf((*MockTaskRepository)(nil).ArchiveCompleted), ctx, categoryID, archivedAt)
+}
+
+// BulkDelete mocks base method.
+func (m *MockTaskRepository) BulkDelete(ctx context.Context, ids []string, deletedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDelete", ctx, ids, deletedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkDelete indicates an expected call of BulkDelete.
+func (mr *MockTaskRepositoryMockRecorder) BulkDelete(ctx, ids, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDelete", reflect.TypeOf((*MockTaskRepository)(nil).BulkDelete), ctx, ids, deletedAt)
+}
+
+// BulkUpdate mocks base method.
+func (m *MockTaskRepository) BulkUpdate(ctx context.Context, updates []repository.UpdateTaskInput, nextOccurrences map[string]domain.Task) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdate", ctx, updates, nextOccurrences)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdate indicates an expected call of BulkUpdate.
+func (mr *MockTaskRepositoryMockRecorder) BulkUpdate(ctx, updates, nextOccurrences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdate", reflect.TypeOf((*MockTaskRepository)(nil).BulkUpdate), ctx, updates, nextOccurrences)
+}
+
 // Create mocks base method.
 func (m *MockTaskRepository) Create(ctx context.Context, task domain.Task) (repository.TaskOutput, error) {
 	m.ctrl.T.Helper()
@@ -125,18 +170,48 @@ func (mr *MockTaskRepositoryMockRecorder) Create(ctx, task any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepository)(nil).Create), ctx, task)
 }
 
+// CreateList mocks base method.
+func (m *MockTaskRepository) CreateList(ctx context.Context, inps []repository.CreateTaskInput) ([]repository.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", ctx, inps)
+	ret0, _ := ret[0].([]repository.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockTaskRepositoryMockRecorder) CreateList(ctx, inps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockTaskRepository)(nil).CreateList), ctx, inps)
+}
+
+// CreateNextOccurrence mocks base method.
+func (m *MockTaskRepository) CreateNextOccurrence(ctx context.Context, previousTaskID string, next domain.Task) (repository.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNextOccurrence", ctx, previousTaskID, next)
+	ret0, _ := ret[0].(repository.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNextOccurrence indicates an expected call of CreateNextOccurrence.
+func (mr *MockTaskRepositoryMockRecorder) CreateNextOccurrence(ctx, previousTaskID, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNextOccurrence", reflect.TypeOf((*MockTaskRepository)(nil).CreateNextOccurrence), ctx, previousTaskID, next)
+}
+
 // Delete mocks base method.
-func (m *MockTaskRepository) Delete(ctx context.Context, id string) error {
+func (m *MockTaskRepository) Delete(ctx context.Context, id string, deletedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, deletedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, id, deletedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, id, deletedAt)
 }
 
 // GetByID mocks base method.
@@ -154,6 +229,128 @@ func (mr *MockTaskRepositoryMockRecorder) GetByID(ctx, taskID, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTaskRepository)(nil).GetByID), ctx, taskID, userID)
 }
 
+// GetByIDForUpdate mocks base method.
+func (m *MockTaskRepository) GetByIDForUpdate(ctx context.Context, taskID, userID string) (repository.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, taskID, userID)
+	ret0, _ := ret[0].(repository.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockTaskRepositoryMockRecorder) GetByIDForUpdate(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockTaskRepository)(nil).GetByIDForUpdate), ctx, taskID, userID)
+}
+
+// GetCursorListByUserID mocks base method.
+func (m *MockTaskRepository) GetCursorListByUserID(ctx context.Context, userID string, query domain.GetTasksQuery, after *domain.Cursor) ([]repository.TaskOutput, *domain.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCursorListByUserID", ctx, userID, query, after)
+	ret0, _ := ret[0].([]repository.TaskOutput)
+	ret1, _ := ret[1].(*domain.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCursorListByUserID indicates an expected call of GetCursorListByUserID.
+func (mr *MockTaskRepositoryMockRecorder) GetCursorListByUserID(ctx, userID, query, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCursorListByUserID", reflect.TypeOf((*MockTaskRepository)(nil).GetCursorListByUserID), ctx, userID, query, after)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockTaskRepository) GetDeletedByID(ctx context.Context, taskID, userID string) (repository.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, taskID, userID)
+	ret0, _ := ret[0].(repository.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockTaskRepositoryMockRecorder) GetDeletedByID(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockTaskRepository)(nil).GetDeletedByID), ctx, taskID, userID)
+}
+
+// GetDeletedListByUserID mocks base method.
+func (m *MockTaskRepository) GetDeletedListByUserID(ctx context.Context, userID string, query domain.PaginationQuery) ([]repository.TaskOutput, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedListByUserID", ctx, userID, query)
+	ret0, _ := ret[0].([]repository.TaskOutput)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedListByUserID indicates an expected call of GetDeletedListByUserID.
+func (mr *MockTaskRepositoryMockRecorder) GetDeletedListByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedListByUserID", reflect.TypeOf((*MockTaskRepository)(nil).GetDeletedListByUserID), ctx, userID, query)
+}
+
+// GetFirstPositionBetween mocks base method.
+func (m *MockTaskRepository) GetFirstPositionBetween(ctx context.Context, categoryID, prev, next string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirstPositionBetween", ctx, categoryID, prev, next)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirstPositionBetween indicates an expected call of GetFirstPositionBetween.
+func (mr *MockTaskRepositoryMockRecorder) GetFirstPositionBetween(ctx, categoryID, prev, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstPositionBetween", reflect.TypeOf((*MockTaskRepository)(nil).GetFirstPositionBetween), ctx, categoryID, prev, next)
+}
+
+// GetIDsByFilters mocks base method.
+func (m *MockTaskRepository) GetIDsByFilters(ctx context.Context, userID string, filters domain.TaskFiltersQuery, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDsByFilters", ctx, userID, filters, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIDsByFilters indicates an expected call of GetIDsByFilters.
+func (mr *MockTaskRepositoryMockRecorder) GetIDsByFilters(ctx, userID, filters, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDsByFilters", reflect.TypeOf((*MockTaskRepository)(nil).GetIDsByFilters), ctx, userID, filters, limit)
+}
+
+// GetLastPosition mocks base method.
+func (m *MockTaskRepository) GetLastPosition(ctx context.Context, categoryID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastPosition", ctx, categoryID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastPosition indicates an expected call of GetLastPosition.
+func (mr *MockTaskRepositoryMockRecorder) GetLastPosition(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastPosition", reflect.TypeOf((*MockTaskRepository)(nil).GetLastPosition), ctx, categoryID)
+}
+
+// GetListByIDs mocks base method.
+func (m *MockTaskRepository) GetListByIDs(ctx context.Context, taskIDs []string, userID string) ([]repository.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByIDs", ctx, taskIDs, userID)
+	ret0, _ := ret[0].([]repository.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByIDs indicates an expected call of GetListByIDs.
+func (mr *MockTaskRepositoryMockRecorder) GetListByIDs(ctx, taskIDs, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByIDs", reflect.TypeOf((*MockTaskRepository)(nil).GetListByIDs), ctx, taskIDs, userID)
+}
+
 // GetListByUserID mocks base method.
 func (m *MockTaskRepository) GetListByUserID(ctx context.Context, userID string, query domain.GetTasksQuery) ([]repository.TaskOutput, int64, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +367,92 @@ func (mr *MockTaskRepositoryMockRecorder) GetListByUserID(ctx, userID, query any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByUserID", reflect.TypeOf((*MockTaskRepository)(nil).GetListByUserID), ctx, userID, query)
 }
 
+// GetRecurringBySeriesID mocks base method.
+func (m *MockTaskRepository) GetRecurringBySeriesID(ctx context.Context, seriesID, userID string) (repository.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringBySeriesID", ctx, seriesID, userID)
+	ret0, _ := ret[0].(repository.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringBySeriesID indicates an expected call of GetRecurringBySeriesID.
+func (mr *MockTaskRepositoryMockRecorder) GetRecurringBySeriesID(ctx, seriesID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringBySeriesID", reflect.TypeOf((*MockTaskRepository)(nil).GetRecurringBySeriesID), ctx, seriesID, userID)
+}
+
+// LockPositions mocks base method.
+func (m *MockTaskRepository) LockPositions(ctx context.Context, categoryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPositions", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPositions indicates an expected call of LockPositions.
+func (mr *MockTaskRepositoryMockRecorder) LockPositions(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPositions", reflect.TypeOf((*MockTaskRepository)(nil).LockPositions), ctx, categoryID)
+}
+
+// Purge mocks base method.
+func (m *MockTaskRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTaskRepositoryMockRecorder) Purge(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTaskRepository)(nil).Purge), ctx, deletedBefore)
+}
+
+// RebalancePositions mocks base method.
+func (m *MockTaskRepository) RebalancePositions(ctx context.Context, categoryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalancePositions", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalancePositions indicates an expected call of RebalancePositions.
+func (mr *MockTaskRepositoryMockRecorder) RebalancePositions(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalancePositions", reflect.TypeOf((*MockTaskRepository)(nil).RebalancePositions), ctx, categoryID)
+}
+
+// Restore mocks base method.
+func (m *MockTaskRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTaskRepository)(nil).Restore), ctx, id)
+}
+
+// SetArchived mocks base method.
+func (m *MockTaskRepository) SetArchived(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArchived", ctx, id, archivedAt, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArchived indicates an expected call of SetArchived.
+func (mr *MockTaskRepositoryMockRecorder) SetArchived(ctx, id, archivedAt, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArchived", reflect.TypeOf((*MockTaskRepository)(nil).SetArchived), ctx, id, archivedAt, updatedAt)
+}
+
 // Update mocks base method.
 func (m *MockTaskRepository) Update(ctx context.Context, inp repository.UpdateTaskInput) (repository.TaskOutput, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +468,20 @@ func (mr *MockTaskRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), ctx, inp)
 }
 
+// UpdateRecurrence mocks base method.
+func (m *MockTaskRepository) UpdateRecurrence(ctx context.Context, inp repository.UpdateTaskRecurrenceInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurrence", ctx, inp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecurrence indicates an expected call of UpdateRecurrence.
+func (mr *MockTaskRepositoryMockRecorder) UpdateRecurrence(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurrence", reflect.TypeOf((*MockTaskRepository)(nil).UpdateRecurrence), ctx, inp)
+}
+
 // MockCategoryRepository is a mock of CategoryRepository interface.
 type MockCategoryRepository struct {
 	ctrl     *gomock.Controller
@@ -225,17 +522,17 @@ func (mr *MockCategoryRepositoryMockRecorder) Create(ctx, category any) *gomock.
 }
 
 // Delete mocks base method.
-func (m *MockCategoryRepository) Delete(ctx context.Context, id string) error {
+func (m *MockCategoryRepository) Delete(ctx context.Context, id string, deletedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, deletedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockCategoryRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockCategoryRepositoryMockRecorder) Delete(ctx, id, deletedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), ctx, id, deletedAt)
 }
 
 // GetByID mocks base method.
@@ -253,6 +550,52 @@ func (mr *MockCategoryRepositoryMockRecorder) GetByID(ctx, categoryID, userID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCategoryRepository)(nil).GetByID), ctx, categoryID, userID)
 }
 
+// GetByTitle mocks base method.
+func (m *MockCategoryRepository) GetByTitle(ctx context.Context, title, userID string) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTitle", ctx, title, userID)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTitle indicates an expected call of GetByTitle.
+func (mr *MockCategoryRepositoryMockRecorder) GetByTitle(ctx, title, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTitle", reflect.TypeOf((*MockCategoryRepository)(nil).GetByTitle), ctx, title, userID)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockCategoryRepository) GetDeletedByID(ctx context.Context, categoryID, userID string) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, categoryID, userID)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockCategoryRepositoryMockRecorder) GetDeletedByID(ctx, categoryID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockCategoryRepository)(nil).GetDeletedByID), ctx, categoryID, userID)
+}
+
+// GetDeletedListByUserID mocks base method.
+func (m *MockCategoryRepository) GetDeletedListByUserID(ctx context.Context, userID string, query domain.PaginationQuery) ([]domain.Category, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedListByUserID", ctx, userID, query)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedListByUserID indicates an expected call of GetDeletedListByUserID.
+func (mr *MockCategoryRepositoryMockRecorder) GetDeletedListByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedListByUserID", reflect.TypeOf((*MockCategoryRepository)(nil).GetDeletedListByUserID), ctx, userID, query)
+}
+
 // GetListByUserID mocks base method.
 func (m *MockCategoryRepository) GetListByUserID(ctx context.Context, userID string) ([]domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +611,35 @@ func (mr *MockCategoryRepositoryMockRecorder) GetListByUserID(ctx, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByUserID", reflect.TypeOf((*MockCategoryRepository)(nil).GetListByUserID), ctx, userID)
 }
 
+// Purge mocks base method.
+func (m *MockCategoryRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockCategoryRepositoryMockRecorder) Purge(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockCategoryRepository)(nil).Purge), ctx, deletedBefore)
+}
+
+// Restore mocks base method.
+func (m *MockCategoryRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCategoryRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCategoryRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockCategoryRepository) Update(ctx context.Context, inp repository.UpdateCategoryInput) (domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -282,3 +654,1101 @@ func (mr *MockCategoryRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryRepository)(nil).Update), ctx, inp)
 }
+
+// MockChecklistItemRepository is a mock of ChecklistItemRepository interface.
+type MockChecklistItemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistItemRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistItemRepositoryMockRecorder is the mock recorder for MockChecklistItemRepository.
+type MockChecklistItemRepositoryMockRecorder struct {
+	mock *MockChecklistItemRepository
+}
+
+// NewMockChecklistItemRepository creates a new mock instance.
+func NewMockChecklistItemRepository(ctrl *gomock.Controller) *MockChecklistItemRepository {
+	mock := &MockChecklistItemRepository{ctrl: ctrl}
+	mock.recorder = &MockChecklistItemRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistItemRepository) EXPECT() *MockChecklistItemRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChecklistItemRepository) Create(ctx context.Context, item domain.ChecklistItem) (domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChecklistItemRepositoryMockRecorder) Create(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChecklistItemRepository)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *MockChecklistItemRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChecklistItemRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChecklistItemRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockChecklistItemRepository) GetByID(ctx context.Context, itemID, taskID string) (domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, itemID, taskID)
+	ret0, _ := ret[0].(domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockChecklistItemRepositoryMockRecorder) GetByID(ctx, itemID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockChecklistItemRepository)(nil).GetByID), ctx, itemID, taskID)
+}
+
+// GetListByTaskID mocks base method.
+func (m *MockChecklistItemRepository) GetListByTaskID(ctx context.Context, taskID string) ([]domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTaskID indicates an expected call of GetListByTaskID.
+func (mr *MockChecklistItemRepositoryMockRecorder) GetListByTaskID(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskID", reflect.TypeOf((*MockChecklistItemRepository)(nil).GetListByTaskID), ctx, taskID)
+}
+
+// Reorder mocks base method.
+func (m *MockChecklistItemRepository) Reorder(ctx context.Context, taskID string, itemIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, taskID, itemIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockChecklistItemRepositoryMockRecorder) Reorder(ctx, taskID, itemIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockChecklistItemRepository)(nil).Reorder), ctx, taskID, itemIDs)
+}
+
+// Update mocks base method.
+func (m *MockChecklistItemRepository) Update(ctx context.Context, inp repository.UpdateChecklistItemInput) (domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistItemRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistItemRepository)(nil).Update), ctx, inp)
+}
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTagRepository) Create(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tag)
+	ret0, _ := ret[0].(domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagRepositoryMockRecorder) Create(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagRepository)(nil).Create), ctx, tag)
+}
+
+// Delete mocks base method.
+func (m *MockTagRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockTagRepository) GetByID(ctx context.Context, tagID, userID string) (domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, tagID, userID)
+	ret0, _ := ret[0].(domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTagRepositoryMockRecorder) GetByID(ctx, tagID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTagRepository)(nil).GetByID), ctx, tagID, userID)
+}
+
+// GetListByIDs mocks base method.
+func (m *MockTagRepository) GetListByIDs(ctx context.Context, tagIDs []string, userID string) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByIDs", ctx, tagIDs, userID)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByIDs indicates an expected call of GetListByIDs.
+func (mr *MockTagRepositoryMockRecorder) GetListByIDs(ctx, tagIDs, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByIDs", reflect.TypeOf((*MockTagRepository)(nil).GetListByIDs), ctx, tagIDs, userID)
+}
+
+// GetListByTaskIDs mocks base method.
+func (m *MockTagRepository) GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskIDs", ctx, taskIDs)
+	ret0, _ := ret[0].(map[string][]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTaskIDs indicates an expected call of GetListByTaskIDs.
+func (mr *MockTagRepositoryMockRecorder) GetListByTaskIDs(ctx, taskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskIDs", reflect.TypeOf((*MockTagRepository)(nil).GetListByTaskIDs), ctx, taskIDs)
+}
+
+// GetListByTitles mocks base method.
+func (m *MockTagRepository) GetListByTitles(ctx context.Context, titles []string, userID string) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTitles", ctx, titles, userID)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTitles indicates an expected call of GetListByTitles.
+func (mr *MockTagRepositoryMockRecorder) GetListByTitles(ctx, titles, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTitles", reflect.TypeOf((*MockTagRepository)(nil).GetListByTitles), ctx, titles, userID)
+}
+
+// GetListByUserID mocks base method.
+func (m *MockTagRepository) GetListByUserID(ctx context.Context, userID string) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByUserID indicates an expected call of GetListByUserID.
+func (mr *MockTagRepositoryMockRecorder) GetListByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByUserID", reflect.TypeOf((*MockTagRepository)(nil).GetListByUserID), ctx, userID)
+}
+
+// SetTaskTags mocks base method.
+func (m *MockTagRepository) SetTaskTags(ctx context.Context, taskID string, tagIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskTags", ctx, taskID, tagIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTaskTags indicates an expected call of SetTaskTags.
+func (mr *MockTagRepositoryMockRecorder) SetTaskTags(ctx, taskID, tagIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskTags", reflect.TypeOf((*MockTagRepository)(nil).SetTaskTags), ctx, taskID, tagIDs)
+}
+
+// Update mocks base method.
+func (m *MockTagRepository) Update(ctx context.Context, inp repository.UpdateTagInput) (domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTagRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagRepository)(nil).Update), ctx, inp)
+}
+
+// MockTaskDependencyRepository is a mock of TaskDependencyRepository interface.
+type MockTaskDependencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskDependencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskDependencyRepositoryMockRecorder is the mock recorder for MockTaskDependencyRepository.
+type MockTaskDependencyRepositoryMockRecorder struct {
+	mock *MockTaskDependencyRepository
+}
+
+// NewMockTaskDependencyRepository creates a new mock instance.
+func NewMockTaskDependencyRepository(ctrl *gomock.Controller) *MockTaskDependencyRepository {
+	mock := &MockTaskDependencyRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskDependencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskDependencyRepository) EXPECT() *MockTaskDependencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTaskDependencyRepository) Create(ctx context.Context, taskID, blockerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, taskID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskDependencyRepositoryMockRecorder) Create(ctx, taskID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskDependencyRepository)(nil).Create), ctx, taskID, blockerID)
+}
+
+// Delete mocks base method.
+func (m *MockTaskDependencyRepository) Delete(ctx context.Context, taskID, blockerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, taskID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskDependencyRepositoryMockRecorder) Delete(ctx, taskID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskDependencyRepository)(nil).Delete), ctx, taskID, blockerID)
+}
+
+// GetBlockedTaskIDs mocks base method.
+func (m *MockTaskDependencyRepository) GetBlockedTaskIDs(ctx context.Context, taskIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedTaskIDs", ctx, taskIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedTaskIDs indicates an expected call of GetBlockedTaskIDs.
+func (mr *MockTaskDependencyRepositoryMockRecorder) GetBlockedTaskIDs(ctx, taskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedTaskIDs", reflect.TypeOf((*MockTaskDependencyRepository)(nil).GetBlockedTaskIDs), ctx, taskIDs)
+}
+
+// GetBlockersByUserID mocks base method.
+func (m *MockTaskDependencyRepository) GetBlockersByUserID(ctx context.Context, userID string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockersByUserID", ctx, userID)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockersByUserID indicates an expected call of GetBlockersByUserID.
+func (mr *MockTaskDependencyRepositoryMockRecorder) GetBlockersByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockersByUserID", reflect.TypeOf((*MockTaskDependencyRepository)(nil).GetBlockersByUserID), ctx, userID)
+}
+
+// GetListByTaskIDs mocks base method.
+func (m *MockTaskDependencyRepository) GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]string, map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskIDs", ctx, taskIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(map[string][]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetListByTaskIDs indicates an expected call of GetListByTaskIDs.
+func (mr *MockTaskDependencyRepositoryMockRecorder) GetListByTaskIDs(ctx, taskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskIDs", reflect.TypeOf((*MockTaskDependencyRepository)(nil).GetListByTaskIDs), ctx, taskIDs)
+}
+
+// LockByUserID mocks base method.
+func (m *MockTaskDependencyRepository) LockByUserID(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByUserID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockByUserID indicates an expected call of LockByUserID.
+func (mr *MockTaskDependencyRepositoryMockRecorder) LockByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByUserID", reflect.TypeOf((*MockTaskDependencyRepository)(nil).LockByUserID), ctx, userID)
+}
+
+// MockTimeEntryRepository is a mock of TimeEntryRepository interface.
+type MockTimeEntryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeEntryRepositoryMockRecorder is the mock recorder for MockTimeEntryRepository.
+type MockTimeEntryRepositoryMockRecorder struct {
+	mock *MockTimeEntryRepository
+}
+
+// NewMockTimeEntryRepository creates a new mock instance.
+func NewMockTimeEntryRepository(ctrl *gomock.Controller) *MockTimeEntryRepository {
+	mock := &MockTimeEntryRepository{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntryRepository) EXPECT() *MockTimeEntryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTimeEntryRepository) Create(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTimeEntryRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTimeEntryRepository)(nil).Create), ctx, entry)
+}
+
+// Delete mocks base method.
+func (m *MockTimeEntryRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTimeEntryRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTimeEntryRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockTimeEntryRepository) GetByID(ctx context.Context, entryID, taskID string) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, entryID, taskID)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTimeEntryRepositoryMockRecorder) GetByID(ctx, entryID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTimeEntryRepository)(nil).GetByID), ctx, entryID, taskID)
+}
+
+// GetListByTaskID mocks base method.
+func (m *MockTimeEntryRepository) GetListByTaskID(ctx context.Context, taskID string) ([]domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTaskID indicates an expected call of GetListByTaskID.
+func (mr *MockTimeEntryRepositoryMockRecorder) GetListByTaskID(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskID", reflect.TypeOf((*MockTimeEntryRepository)(nil).GetListByTaskID), ctx, taskID)
+}
+
+// GetReport mocks base method.
+func (m *MockTimeEntryRepository) GetReport(ctx context.Context, userID string, from, to time.Time, timezone string) (domain.TimeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, userID, from, to, timezone)
+	ret0, _ := ret[0].(domain.TimeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockTimeEntryRepositoryMockRecorder) GetReport(ctx, userID, from, to, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockTimeEntryRepository)(nil).GetReport), ctx, userID, from, to, timezone)
+}
+
+// GetRunningByUserID mocks base method.
+func (m *MockTimeEntryRepository) GetRunningByUserID(ctx context.Context, userID string) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningByUserID", ctx, userID)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningByUserID indicates an expected call of GetRunningByUserID.
+func (mr *MockTimeEntryRepositoryMockRecorder) GetRunningByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningByUserID", reflect.TypeOf((*MockTimeEntryRepository)(nil).GetRunningByUserID), ctx, userID)
+}
+
+// Stop mocks base method.
+func (m *MockTimeEntryRepository) Stop(ctx context.Context, id string, endedAt time.Time) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, id, endedAt)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockTimeEntryRepositoryMockRecorder) Stop(ctx, id, endedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTimeEntryRepository)(nil).Stop), ctx, id, endedAt)
+}
+
+// MockCommentRepository is a mock of CommentRepository interface.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock instance.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCommentRepository) Create(ctx context.Context, comment domain.Comment) (domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, comment)
+	ret0, _ := ret[0].(domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentRepositoryMockRecorder) Create(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentRepository)(nil).Create), ctx, comment)
+}
+
+// Delete mocks base method.
+func (m *MockCommentRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockCommentRepository) GetByID(ctx context.Context, commentID, taskID string) (domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, commentID, taskID)
+	ret0, _ := ret[0].(domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCommentRepositoryMockRecorder) GetByID(ctx, commentID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCommentRepository)(nil).GetByID), ctx, commentID, taskID)
+}
+
+// GetListByTaskID mocks base method.
+func (m *MockCommentRepository) GetListByTaskID(ctx context.Context, taskID string) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTaskID indicates an expected call of GetListByTaskID.
+func (mr *MockCommentRepositoryMockRecorder) GetListByTaskID(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskID", reflect.TypeOf((*MockCommentRepository)(nil).GetListByTaskID), ctx, taskID)
+}
+
+// Update mocks base method.
+func (m *MockCommentRepository) Update(ctx context.Context, inp repository.UpdateCommentInput) (domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentRepository)(nil).Update), ctx, inp)
+}
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAttachmentRepository) Create(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, attachment)
+	ret0, _ := ret[0].(domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentRepositoryMockRecorder) Create(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachmentRepository)(nil).Create), ctx, attachment)
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockAttachmentRepository) GetByID(ctx context.Context, attachmentID, taskID string) (domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, attachmentID, taskID)
+	ret0, _ := ret[0].(domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAttachmentRepositoryMockRecorder) GetByID(ctx, attachmentID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAttachmentRepository)(nil).GetByID), ctx, attachmentID, taskID)
+}
+
+// GetListByTaskIDs mocks base method.
+func (m *MockAttachmentRepository) GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskIDs", ctx, taskIDs)
+	ret0, _ := ret[0].(map[string][]domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTaskIDs indicates an expected call of GetListByTaskIDs.
+func (mr *MockAttachmentRepositoryMockRecorder) GetListByTaskIDs(ctx, taskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskIDs", reflect.TypeOf((*MockAttachmentRepository)(nil).GetListByTaskIDs), ctx, taskIDs)
+}
+
+// GetStorageKeysOfDeletedTasks mocks base method.
+func (m *MockAttachmentRepository) GetStorageKeysOfDeletedTasks(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageKeysOfDeletedTasks", ctx, deletedBefore)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageKeysOfDeletedTasks indicates an expected call of GetStorageKeysOfDeletedTasks.
+func (mr *MockAttachmentRepositoryMockRecorder) GetStorageKeysOfDeletedTasks(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageKeysOfDeletedTasks", reflect.TypeOf((*MockAttachmentRepository)(nil).GetStorageKeysOfDeletedTasks), ctx, deletedBefore)
+}
+
+// GetTotalSizeByUserID mocks base method.
+func (m *MockAttachmentRepository) GetTotalSizeByUserID(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalSizeByUserID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalSizeByUserID indicates an expected call of GetTotalSizeByUserID.
+func (mr *MockAttachmentRepositoryMockRecorder) GetTotalSizeByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalSizeByUserID", reflect.TypeOf((*MockAttachmentRepository)(nil).GetTotalSizeByUserID), ctx, userID)
+}
+
+// MockActivityRepository is a mock of ActivityRepository interface.
+type MockActivityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityRepositoryMockRecorder is the mock recorder for MockActivityRepository.
+type MockActivityRepositoryMockRecorder struct {
+	mock *MockActivityRepository
+}
+
+// NewMockActivityRepository creates a new mock instance.
+func NewMockActivityRepository(ctrl *gomock.Controller) *MockActivityRepository {
+	mock := &MockActivityRepository{ctrl: ctrl}
+	mock.recorder = &MockActivityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityRepository) EXPECT() *MockActivityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockActivityRepository) Create(ctx context.Context, activities ...domain.Activity) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range activities {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockActivityRepositoryMockRecorder) Create(ctx any, activities ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, activities...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockActivityRepository)(nil).Create), varargs...)
+}
+
+// GetCursorListByUserID mocks base method.
+func (m *MockActivityRepository) GetCursorListByUserID(ctx context.Context, userID string, query domain.PaginationQuery, after *domain.Cursor) ([]domain.Activity, *domain.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCursorListByUserID", ctx, userID, query, after)
+	ret0, _ := ret[0].([]domain.Activity)
+	ret1, _ := ret[1].(*domain.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCursorListByUserID indicates an expected call of GetCursorListByUserID.
+func (mr *MockActivityRepositoryMockRecorder) GetCursorListByUserID(ctx, userID, query, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCursorListByUserID", reflect.TypeOf((*MockActivityRepository)(nil).GetCursorListByUserID), ctx, userID, query, after)
+}
+
+// GetListByTaskID mocks base method.
+func (m *MockActivityRepository) GetListByTaskID(ctx context.Context, taskID string) ([]domain.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]domain.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByTaskID indicates an expected call of GetListByTaskID.
+func (mr *MockActivityRepositoryMockRecorder) GetListByTaskID(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByTaskID", reflect.TypeOf((*MockActivityRepository)(nil).GetListByTaskID), ctx, taskID)
+}
+
+// GetListByUserID mocks base method.
+func (m *MockActivityRepository) GetListByUserID(ctx context.Context, userID string, query domain.PaginationQuery) ([]domain.Activity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByUserID", ctx, userID, query)
+	ret0, _ := ret[0].([]domain.Activity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetListByUserID indicates an expected call of GetListByUserID.
+func (mr *MockActivityRepositoryMockRecorder) GetListByUserID(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByUserID", reflect.TypeOf((*MockActivityRepository)(nil).GetListByUserID), ctx, userID, query)
+}
+
+// MockUndoRepository is a mock of UndoRepository interface.
+type MockUndoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUndoRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUndoRepositoryMockRecorder is the mock recorder for MockUndoRepository.
+type MockUndoRepositoryMockRecorder struct {
+	mock *MockUndoRepository
+}
+
+// NewMockUndoRepository creates a new mock instance.
+func NewMockUndoRepository(ctrl *gomock.Controller) *MockUndoRepository {
+	mock := &MockUndoRepository{ctrl: ctrl}
+	mock.recorder = &MockUndoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUndoRepository) EXPECT() *MockUndoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUndoRepository) Create(ctx context.Context, operation domain.UndoOperation) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, operation)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUndoRepositoryMockRecorder) Create(ctx, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUndoRepository)(nil).Create), ctx, operation)
+}
+
+// Redeem mocks base method.
+func (m *MockUndoRepository) Redeem(ctx context.Context, id, userID string, undoneAt time.Time) (domain.UndoOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, id, userID, undoneAt)
+	ret0, _ := ret[0].(domain.UndoOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockUndoRepositoryMockRecorder) Redeem(ctx, id, userID, undoneAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockUndoRepository)(nil).Redeem), ctx, id, userID, undoneAt)
+}
+
+// MockTemplateRepository is a mock of TemplateRepository interface.
+type MockTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTemplateRepositoryMockRecorder is the mock recorder for MockTemplateRepository.
+type MockTemplateRepositoryMockRecorder struct {
+	mock *MockTemplateRepository
+}
+
+// NewMockTemplateRepository creates a new mock instance.
+func NewMockTemplateRepository(ctrl *gomock.Controller) *MockTemplateRepository {
+	mock := &MockTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateRepository) EXPECT() *MockTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTemplateRepository) Create(ctx context.Context, template domain.TaskTemplate) (domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, template)
+	ret0, _ := ret[0].(domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTemplateRepositoryMockRecorder) Create(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTemplateRepository)(nil).Create), ctx, template)
+}
+
+// Delete mocks base method.
+func (m *MockTemplateRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTemplateRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockTemplateRepository) GetByID(ctx context.Context, templateID, userID string) (domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, templateID, userID)
+	ret0, _ := ret[0].(domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTemplateRepositoryMockRecorder) GetByID(ctx, templateID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTemplateRepository)(nil).GetByID), ctx, templateID, userID)
+}
+
+// GetListByUserID mocks base method.
+func (m *MockTemplateRepository) GetListByUserID(ctx context.Context, userID string) ([]domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByUserID indicates an expected call of GetListByUserID.
+func (mr *MockTemplateRepositoryMockRecorder) GetListByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByUserID", reflect.TypeOf((*MockTemplateRepository)(nil).GetListByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockTemplateRepository) Update(ctx context.Context, inp repository.UpdateTemplateInput) (domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTemplateRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTemplateRepository)(nil).Update), ctx, inp)
+}
+
+// MockSmartListRepository is a mock of SmartListRepository interface.
+type MockSmartListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSmartListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSmartListRepositoryMockRecorder is the mock recorder for MockSmartListRepository.
+type MockSmartListRepositoryMockRecorder struct {
+	mock *MockSmartListRepository
+}
+
+// NewMockSmartListRepository creates a new mock instance.
+func NewMockSmartListRepository(ctrl *gomock.Controller) *MockSmartListRepository {
+	mock := &MockSmartListRepository{ctrl: ctrl}
+	mock.recorder = &MockSmartListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSmartListRepository) EXPECT() *MockSmartListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSmartListRepository) Create(ctx context.Context, list domain.SmartList) (domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, list)
+	ret0, _ := ret[0].(domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSmartListRepositoryMockRecorder) Create(ctx, list any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSmartListRepository)(nil).Create), ctx, list)
+}
+
+// Delete mocks base method.
+func (m *MockSmartListRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSmartListRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSmartListRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockSmartListRepository) GetByID(ctx context.Context, listID, userID string) (domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, listID, userID)
+	ret0, _ := ret[0].(domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSmartListRepositoryMockRecorder) GetByID(ctx, listID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSmartListRepository)(nil).GetByID), ctx, listID, userID)
+}
+
+// GetListByUserID mocks base method.
+func (m *MockSmartListRepository) GetListByUserID(ctx context.Context, userID string) ([]domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByUserID indicates an expected call of GetListByUserID.
+func (mr *MockSmartListRepositoryMockRecorder) GetListByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByUserID", reflect.TypeOf((*MockSmartListRepository)(nil).GetListByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockSmartListRepository) Update(ctx context.Context, inp repository.UpdateSmartListInput) (domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSmartListRepositoryMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSmartListRepository)(nil).Update), ctx, inp)
+}
+
+// MockTransactor is a mock of Transactor interface.
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactorMockRecorder is the mock recorder for MockTransactor.
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+// NewMockTransactor creates a new mock instance.
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+// WithinTransaction mocks base method.
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTransaction indicates an expected call of WithinTransaction.
+func (mr *MockTransactorMockRecorder) WithinTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTransaction", reflect.TypeOf((*MockTransactor)(nil).WithinTransaction), ctx, fn)
+}
@@ -16,31 +16,125 @@ type UserRepository interface {
 }
 
 type UpdateTaskInput struct {
-	ID          string    `json:"id"`
-	UserID      string    `json:"user_id"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	CategoryID  *string   `json:"category_id"`
-	Title       *string   `json:"title"`
-	Description *string   `json:"description"`
-	Completed   *bool     `json:"completed"`
+	ID          string               `json:"id"`
+	UserID      string               `json:"user_id"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	CategoryID  *string              `json:"category_id"`
+	Title       *string              `json:"title"`
+	Description *string              `json:"description"`
+	Status      *domain.TaskStatus   `json:"status"`
+	Priority    *domain.TaskPriority `json:"priority"`
+	// Due replaces all due date columns when set, nil fields are stored as NULL.
+	Due      *domain.TaskDue `json:"due"`
+	Position *string         `json:"position"`
+	// EstimateMinutes set to 0 clears the estimate.
+	EstimateMinutes *int `json:"estimate_minutes"`
+}
+
+// CreateTaskInput is a task created by CreateList together with its tags and checklist items given by title.
+type CreateTaskInput struct {
+	Task           domain.Task `json:"task"`
+	TagIDs         []string    `json:"tag_ids"`
+	ChecklistItems []string    `json:"checklist_items"`
+}
+
+// UpdateTaskRecurrenceInput with nil RecurrenceRule stops the series.
+type UpdateTaskRecurrenceInput struct {
+	ID             string    `json:"id"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	SeriesID       string    `json:"series_id"`
+	RecurrenceRule *string   `json:"recurrence_rule"`
 }
 
 type TaskOutput struct {
-	ID          string          `json:"id" db:"id"`
-	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
-	Category    domain.Category `json:"category"`
-	Title       string          `json:"title" db:"title"`
-	Description string          `json:"description" db:"description"`
-	Completed   bool            `json:"completed" db:"completed"`
+	ID              string              `json:"id" db:"id"`
+	CreatedAt       time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at" db:"updated_at"`
+	Category        domain.Category     `json:"category"`
+	Title           string              `json:"title" db:"title"`
+	Description     string              `json:"description" db:"description"`
+	Completed       bool                `json:"completed" db:"completed"`
+	Status          domain.TaskStatus   `json:"status" db:"status"`
+	Priority        domain.TaskPriority `json:"priority" db:"priority"`
+	DueDate         *time.Time          `json:"due_date" db:"due_date"`
+	DueTime         *string             `json:"due_time" db:"due_time"`
+	DueTimezone     *string             `json:"due_timezone" db:"due_timezone"`
+	DueAt           *time.Time          `json:"due_at" db:"due_at"`
+	EstimateMinutes *int                `json:"estimate_minutes" db:"estimate_minutes"`
+	RecurrenceRule  *string             `json:"recurrence_rule" db:"recurrence_rule"`
+	SeriesID        *string             `json:"series_id" db:"series_id"`
+	Position        string              `json:"position" db:"position"`
+	ArchivedAt      *time.Time          `json:"archived_at" db:"archived_at"`
+	DeletedAt       *time.Time          `json:"deleted_at" db:"deleted_at"`
+	// Tags are loaded separately with TagRepository.GetListByTaskIDs
+	Tags []domain.Tag `json:"tags" db:"-"`
+	// BlockedBy and Blocks are loaded separately with TaskDependencyRepository.GetListByTaskIDs
+	BlockedBy []string `json:"blocked_by" db:"-"`
+	Blocks    []string `json:"blocks" db:"-"`
+	// Attachments are loaded separately with AttachmentRepository.GetListByTaskIDs
+	Attachments []domain.Attachment `json:"attachments" db:"-"`
+	// ChecklistDone and ChecklistTotal count completed and all checklist items of the task
+	ChecklistDone  int `json:"checklist_done" db:"checklist_done"`
+	ChecklistTotal int `json:"checklist_total" db:"checklist_total"`
+	// TrackedMinutes sums time entries of the task including the running timer
+	TrackedMinutes int `json:"tracked_minutes" db:"tracked_minutes"`
+	CommentsCount  int `json:"comments_count" db:"comments_count"`
+	// search fields are set only for full-text search results
+	SearchRank           *float64 `json:"search_rank" db:"search_rank"`
+	TitleHighlight       *string  `json:"title_highlight" db:"title_highlight"`
+	DescriptionHighlight *string  `json:"description_highlight" db:"description_highlight"`
 }
 
 type TaskRepository interface {
 	Create(ctx context.Context, task domain.Task) (TaskOutput, error)
+	// CreateList creates the tasks in one transaction and returns them in the same order.
+	CreateList(ctx context.Context, inps []CreateTaskInput) ([]TaskOutput, error)
 	Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput, error)
-	Delete(ctx context.Context, id string) error
+	// BulkUpdate applies the updates in one transaction and creates next occurrences
+	// of the completed recurring tasks given by the completed task id, ids of the created occurrences are returned the same way.
+	BulkUpdate(
+		ctx context.Context, updates []UpdateTaskInput, nextOccurrences map[string]domain.Task,
+	) (map[string]string, error)
+	// Delete moves the task to the trash.
+	Delete(ctx context.Context, id string, deletedAt time.Time) error
+	BulkDelete(ctx context.Context, ids []string, deletedAt time.Time) error
+	Restore(ctx context.Context, id string) error
+	// SetArchived archives the task at archivedAt or unarchives it when archivedAt is nil.
+	SetArchived(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time) error
+	// ArchiveCompleted archives completed tasks of the category and returns their number.
+	ArchiveCompleted(ctx context.Context, categoryID string, archivedAt time.Time) (int64, error)
 	GetByID(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	// GetByIDForUpdate locks the task row until the end of the transaction of ctx.
+	GetByIDForUpdate(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	GetListByIDs(ctx context.Context, taskIDs []string, userID string) ([]TaskOutput, error)
+	// LockPositions keeps other transactions from giving out positions of the category
+	// until the end of the transaction of ctx.
+	LockPositions(ctx context.Context, categoryID string) error
+	// GetLastPosition returns the greatest position of the category tasks, it is empty for a category without tasks.
+	// Deleted and archived tasks count, their positions stay taken.
+	GetLastPosition(ctx context.Context, categoryID string) (string, error)
+	// GetFirstPositionBetween returns the smallest position of the category tasks between prev and next exclusive,
+	// it is empty when there is none. Deleted and archived tasks count.
+	GetFirstPositionBetween(ctx context.Context, categoryID, prev, next string) (string, error)
+	// RebalancePositions rewrites positions of the category tasks to short keys keeping their order.
+	RebalancePositions(ctx context.Context, categoryID string) error
+	// GetIDsByFilters returns ids of up to limit tasks matching the filters, newest tasks go first.
+	GetIDsByFilters(ctx context.Context, userID string, filters domain.TaskFiltersQuery, limit int) ([]string, error)
+	GetDeletedByID(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	// GetDeletedListByUserID returns a page of deleted tasks and their total number, most recently deleted go first.
+	GetDeletedListByUserID(ctx context.Context, userID string, query domain.PaginationQuery) ([]TaskOutput, int64, error)
+	// Purge permanently removes tasks deleted before the given time.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 	GetListByUserID(ctx context.Context, userID string, query domain.GetTasksQuery) ([]TaskOutput, int64, error)
+	GetCursorListByUserID(
+		ctx context.Context, userID string, query domain.GetTasksQuery, after *domain.Cursor,
+	) ([]TaskOutput, *domain.Cursor, error)
+	UpdateRecurrence(ctx context.Context, inp UpdateTaskRecurrenceInput) error
+	// GetRecurringBySeriesID returns the occurrence carrying the recurrence rule of the series.
+	GetRecurringBySeriesID(ctx context.Context, seriesID, userID string) (TaskOutput, error)
+	// CreateNextOccurrence moves the recurrence rule from the previous occurrence to the created one
+	// and copies its tags and checklist with all items uncompleted.
+	CreateNextOccurrence(ctx context.Context, previousTaskID string, next domain.Task) (TaskOutput, error)
 }
 
 type UpdateCategoryInput struct {
@@ -53,21 +147,210 @@ type UpdateCategoryInput struct {
 type CategoryRepository interface {
 	Create(ctx context.Context, category domain.Category) (domain.Category, error)
 	Update(ctx context.Context, inp UpdateCategoryInput) (domain.Category, error)
-	Delete(ctx context.Context, id string) error
+	// Delete moves the category and its tasks to the trash.
+	Delete(ctx context.Context, id string, deletedAt time.Time) error
+	// Restore brings the category back with the tasks deleted together with it.
+	Restore(ctx context.Context, id string) error
 	GetByID(ctx context.Context, categoryID, userID string) (domain.Category, error)
+	// GetByTitle finds the category by title ignoring case, the exact match is preferred.
+	GetByTitle(ctx context.Context, title, userID string) (domain.Category, error)
 	GetListByUserID(ctx context.Context, userID string) ([]domain.Category, error)
+	GetDeletedByID(ctx context.Context, categoryID, userID string) (domain.Category, error)
+	// GetDeletedListByUserID returns a page of deleted categories and their total number, most recently deleted go first.
+	GetDeletedListByUserID(
+		ctx context.Context, userID string, query domain.PaginationQuery,
+	) ([]domain.Category, int64, error)
+	// Purge permanently removes categories deleted before the given time.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
+
+type UpdateChecklistItemInput struct {
+	ID        string    `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Title     *string   `json:"title"`
+	Completed *bool     `json:"completed"`
+}
+
+type ChecklistItemRepository interface {
+	Create(ctx context.Context, item domain.ChecklistItem) (domain.ChecklistItem, error)
+	Update(ctx context.Context, inp UpdateChecklistItemInput) (domain.ChecklistItem, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, itemID, taskID string) (domain.ChecklistItem, error)
+	GetListByTaskID(ctx context.Context, taskID string) ([]domain.ChecklistItem, error)
+	Reorder(ctx context.Context, taskID string, itemIDs []string) error
+}
+
+type UpdateTagInput struct {
+	ID    string  `json:"id"`
+	Title *string `json:"title"`
+	Color *string `json:"color"`
+}
+
+type TagRepository interface {
+	Create(ctx context.Context, tag domain.Tag) (domain.Tag, error)
+	Update(ctx context.Context, inp UpdateTagInput) (domain.Tag, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, tagID, userID string) (domain.Tag, error)
+	GetListByUserID(ctx context.Context, userID string) ([]domain.Tag, error)
+	GetListByIDs(ctx context.Context, tagIDs []string, userID string) ([]domain.Tag, error)
+	// GetListByTitles finds tags by titles ignoring case.
+	GetListByTitles(ctx context.Context, titles []string, userID string) ([]domain.Tag, error)
+	// GetListByTaskIDs returns tags of the tasks grouped by task id.
+	GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]domain.Tag, error)
+	// SetTaskTags replaces tags of the task.
+	SetTaskTags(ctx context.Context, taskID string, tagIDs []string) error
+}
+
+type TaskDependencyRepository interface {
+	Create(ctx context.Context, taskID, blockerID string) error
+	// LockByUserID keeps other transactions from changing dependencies of the user's tasks
+	// until the end of the transaction of ctx.
+	LockByUserID(ctx context.Context, userID string) error
+	Delete(ctx context.Context, taskID, blockerID string) error
+	// GetBlockersByUserID returns blocker ids of all tasks of the user grouped by task id.
+	GetBlockersByUserID(ctx context.Context, userID string) (map[string][]string, error)
+	// GetListByTaskIDs returns ids of the tasks blocking the tasks and ids of the tasks blocked by them,
+	// both grouped by task id. Tasks in the trash are skipped.
+	GetListByTaskIDs(ctx context.Context, taskIDs []string) (blockedBy, blocks map[string][]string, err error)
+	// GetBlockedTaskIDs returns ids of the tasks having open blockers.
+	GetBlockedTaskIDs(ctx context.Context, taskIDs []string) ([]string, error)
+}
+
+type TimeEntryRepository interface {
+	// Create fails with ErrTimerAlreadyRunning when the entry is a timer and the user has a running one.
+	Create(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error)
+	// Stop ends the running timer at endedAt.
+	Stop(ctx context.Context, id string, endedAt time.Time) (domain.TimeEntry, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, entryID, taskID string) (domain.TimeEntry, error)
+	// GetRunningByUserID returns the running timer of the user or ErrTimerNotRunning.
+	GetRunningByUserID(ctx context.Context, userID string) (domain.TimeEntry, error)
+	GetListByTaskID(ctx context.Context, taskID string) ([]domain.TimeEntry, error)
+	// GetReport sums time entries started in [from, to), days are taken in the timezone.
+	GetReport(ctx context.Context, userID string, from, to time.Time, timezone string) (domain.TimeReport, error)
+}
+
+type UpdateCommentInput struct {
+	ID        string    `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Body      string    `json:"body"`
+}
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment domain.Comment) (domain.Comment, error)
+	Update(ctx context.Context, inp UpdateCommentInput) (domain.Comment, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, commentID, taskID string) (domain.Comment, error)
+	// GetListByTaskID returns comments of the task, oldest comments go first.
+	GetListByTaskID(ctx context.Context, taskID string) ([]domain.Comment, error)
+}
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment domain.Attachment) (domain.Attachment, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, attachmentID, taskID string) (domain.Attachment, error)
+	// GetListByTaskIDs returns attachments of the tasks grouped by task id, oldest attachments go first.
+	GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]domain.Attachment, error)
+	// GetTotalSizeByUserID returns the size of all files attached by the user in bytes.
+	GetTotalSizeByUserID(ctx context.Context, userID string) (int64, error)
+	// GetStorageKeysOfDeletedTasks returns storage keys of the files attached to tasks deleted before the given time.
+	GetStorageKeysOfDeletedTasks(ctx context.Context, deletedBefore time.Time) ([]string, error)
+}
+
+type ActivityRepository interface {
+	// Create records the activities in one statement.
+	Create(ctx context.Context, activities ...domain.Activity) error
+	// GetListByTaskID returns activities of the task, newest activities go first.
+	GetListByTaskID(ctx context.Context, taskID string) ([]domain.Activity, error)
+	// GetListByUserID returns a page of the user activities and their total number, newest activities go first.
+	GetListByUserID(ctx context.Context, userID string, query domain.PaginationQuery) ([]domain.Activity, int64, error)
+	// GetCursorListByUserID returns up to query.Limit user activities older than the cursor,
+	// the returned cursor is nil when there are no more activities.
+	GetCursorListByUserID(
+		ctx context.Context, userID string, query domain.PaginationQuery, after *domain.Cursor,
+	) ([]domain.Activity, *domain.Cursor, error)
+}
+
+type UndoRepository interface {
+	// Create returns the id of the stored operation, it's used as the undo token.
+	Create(ctx context.Context, operation domain.UndoOperation) (string, error)
+	// Redeem restores the task snapshots of the operation and removes it in one transaction,
+	// it fails with ErrUndoConflict when any of the tasks has changed since the operation.
+	Redeem(ctx context.Context, id, userID string, undoneAt time.Time) (domain.UndoOperation, error)
+}
+
+// UpdateTemplateInput non-nil Tasks replace all tasks of the template.
+type UpdateTemplateInput struct {
+	ID          string                `json:"id"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+	Title       *string               `json:"title"`
+	Description *string               `json:"description"`
+	Tasks       *domain.TemplateTasks `json:"tasks"`
+}
+
+type TemplateRepository interface {
+	Create(ctx context.Context, template domain.TaskTemplate) (domain.TaskTemplate, error)
+	Update(ctx context.Context, inp UpdateTemplateInput) (domain.TaskTemplate, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, templateID, userID string) (domain.TaskTemplate, error)
+	// GetListByUserID returns templates of the user ordered by title.
+	GetListByUserID(ctx context.Context, userID string) ([]domain.TaskTemplate, error)
+}
+
+// UpdateSmartListInput non-nil Query replaces the whole query of the list.
+type UpdateSmartListInput struct {
+	ID        string                 `json:"id"`
+	UpdatedAt time.Time              `json:"updated_at"`
+	Title     *string                `json:"title"`
+	Query     *domain.SmartListQuery `json:"query"`
+}
+
+type SmartListRepository interface {
+	Create(ctx context.Context, list domain.SmartList) (domain.SmartList, error)
+	Update(ctx context.Context, inp UpdateSmartListInput) (domain.SmartList, error)
+	Delete(ctx context.Context, id string) error
+	GetByID(ctx context.Context, listID, userID string) (domain.SmartList, error)
+	// GetListByUserID returns smart lists of the user ordered by title.
+	GetListByUserID(ctx context.Context, userID string) ([]domain.SmartList, error)
+}
+
+// Transactor runs fn in a database transaction, repository calls made with the context passed to fn join it.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Repositories struct {
-	User     UserRepository
-	Task     TaskRepository
-	Category CategoryRepository
+	Transactor     Transactor
+	User           UserRepository
+	Task           TaskRepository
+	Category       CategoryRepository
+	ChecklistItem  ChecklistItemRepository
+	Tag            TagRepository
+	TaskDependency TaskDependencyRepository
+	TimeEntry      TimeEntryRepository
+	Comment        CommentRepository
+	Attachment     AttachmentRepository
+	Activity       ActivityRepository
+	Undo           UndoRepository
+	Template       TemplateRepository
+	SmartList      SmartListRepository
 }
 
 func NewRepositories(db *sqlx.DB) *Repositories {
 	return &Repositories{
-		User:     NewUserRepo(db),
-		Task:     NewTaskRepo(db),
-		Category: NewCategoryRepo(db),
+		Transactor:     NewTxManager(db),
+		User:           NewUserRepo(db),
+		Task:           NewTaskRepo(db),
+		Category:       NewCategoryRepo(db),
+		ChecklistItem:  NewChecklistItemRepo(db),
+		Tag:            NewTagRepo(db),
+		TaskDependency: NewTaskDependencyRepo(db),
+		TimeEntry:      NewTimeEntryRepo(db),
+		Comment:        NewCommentRepo(db),
+		Attachment:     NewAttachmentRepo(db),
+		Activity:       NewActivityRepo(db),
+		Undo:           NewUndoRepo(db),
+		Template:       NewTemplateRepo(db),
+		SmartList:      NewSmartListRepo(db),
 	}
 }
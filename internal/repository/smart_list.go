@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const smartListColumns = "id, created_at, updated_at, user_id, title, query"
+
+type SmartListRepo struct {
+	db *sqlx.DB
+}
+
+func NewSmartListRepo(db *sqlx.DB) *SmartListRepo {
+	return &SmartListRepo{db: db}
+}
+
+func (r *SmartListRepo) Create(ctx context.Context, list domain.SmartList) (domain.SmartList, error) {
+	var createdList domain.SmartList
+
+	query := `
+		INSERT INTO smart_lists (created_at, updated_at, user_id, title, query)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + smartListColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, query, list.CreatedAt, list.UpdatedAt, list.UserID, list.Title, list.Query,
+	).StructScan(&createdList)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.SmartList{}, customErrors.ErrSmartListAlreadyExists
+		}
+		return domain.SmartList{}, err
+	}
+
+	return createdList, nil
+}
+
+func (r *SmartListRepo) Update(ctx context.Context, inp UpdateSmartListInput) (domain.SmartList, error) {
+	var updatedList domain.SmartList
+
+	setClause := []string{"updated_at = $1"}
+	args := []interface{}{inp.UpdatedAt}
+	argID := 2
+
+	if inp.Title != nil {
+		setClause = append(setClause, fmt.Sprintf("title = $%d", argID))
+		args = append(args, inp.Title)
+		argID++
+	}
+	if inp.Query != nil {
+		setClause = append(setClause, fmt.Sprintf("query = $%d", argID))
+		args = append(args, inp.Query)
+		argID++
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE smart_lists SET %s WHERE id = $%d RETURNING %s;",
+		strings.Join(setClause, ", "), argID, smartListColumns,
+	)
+	args = append(args, inp.ID)
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, args...).StructScan(&updatedList)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.SmartList{}, customErrors.ErrSmartListAlreadyExists
+		}
+		return domain.SmartList{}, err
+	}
+
+	return updatedList, nil
+}
+
+func (r *SmartListRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM smart_lists WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *SmartListRepo) GetByID(ctx context.Context, listID, userID string) (domain.SmartList, error) {
+	var list domain.SmartList
+
+	query := "SELECT " + smartListColumns + " FROM smart_lists WHERE id = $1 AND user_id = $2;"
+	err := conn(ctx, r.db).GetContext(ctx, &list, query, listID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.SmartList{}, customErrors.ErrSmartListNotFound
+		}
+		return domain.SmartList{}, err
+	}
+
+	return list, nil
+}
+
+func (r *SmartListRepo) GetListByUserID(ctx context.Context, userID string) ([]domain.SmartList, error) {
+	lists := make([]domain.SmartList, 0)
+
+	query := "SELECT " + smartListColumns + " FROM smart_lists WHERE user_id = $1 ORDER BY title;"
+	err := conn(ctx, r.db).SelectContext(ctx, &lists, query, userID)
+
+	return lists, err
+}
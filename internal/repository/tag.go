@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type TagRepo struct {
+	db *sqlx.DB
+}
+
+func NewTagRepo(db *sqlx.DB) *TagRepo {
+	return &TagRepo{db: db}
+}
+
+func (r *TagRepo) Create(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	var createdTag domain.Tag
+	query := `
+		INSERT INTO tags (user_id, created_at, title, color)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, created_at, title, COALESCE(color, '') AS color;`
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, tag.UserID, tag.CreatedAt, tag.Title, tag.Color).StructScan(&createdTag)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.Tag{}, customErrors.ErrTagAlreadyExists
+		}
+		return domain.Tag{}, err
+	}
+
+	return createdTag, nil
+}
+
+func (r *TagRepo) Update(ctx context.Context, inp UpdateTagInput) (domain.Tag, error) {
+	var updatedTag domain.Tag
+
+	setClause := make([]string, 0)
+	args := make([]interface{}, 0)
+	argID := 1
+
+	if inp.Title != nil {
+		setClause = append(setClause, fmt.Sprintf("title = $%d", argID))
+		args = append(args, inp.Title)
+		argID++
+	}
+	if inp.Color != nil {
+		setClause = append(setClause, fmt.Sprintf("color = $%d", argID))
+		args = append(args, inp.Color)
+		argID++
+	}
+
+	setQuery := strings.Join(setClause, ", ")
+	query := fmt.Sprintf(
+		`UPDATE tags SET %s WHERE id = $%d
+		RETURNING id, user_id, created_at, title, COALESCE(color, '') AS color;`,
+		setQuery, argID,
+	)
+	args = append(args, inp.ID)
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, args...).StructScan(&updatedTag)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.Tag{}, customErrors.ErrTagAlreadyExists
+		}
+		return domain.Tag{}, err
+	}
+
+	return updatedTag, nil
+}
+
+func (r *TagRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM tags WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *TagRepo) GetListByUserID(ctx context.Context, userID string) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0)
+
+	query := `
+		SELECT id, user_id, created_at, title, COALESCE(color, '') AS color
+		FROM tags
+		WHERE user_id = $1
+		ORDER BY title;`
+	err := conn(ctx, r.db).SelectContext(ctx, &tags, query, userID)
+
+	return tags, err
+}
+
+func (r *TagRepo) GetByID(ctx context.Context, tagID, userID string) (domain.Tag, error) {
+	var tag domain.Tag
+
+	query := `
+		SELECT id, user_id, created_at, title, COALESCE(color, '') AS color
+		FROM tags
+		WHERE id = $1 AND user_id = $2;`
+	err := conn(ctx, r.db).GetContext(ctx, &tag, query, tagID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Tag{}, customErrors.ErrTagNotFound
+		}
+
+		return domain.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (r *TagRepo) GetListByIDs(ctx context.Context, tagIDs []string, userID string) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0)
+
+	query := `
+		SELECT id, user_id, created_at, title, COALESCE(color, '') AS color
+		FROM tags
+		WHERE id = ANY($1) AND user_id = $2;`
+	err := conn(ctx, r.db).SelectContext(ctx, &tags, query, pq.Array(tagIDs), userID)
+
+	return tags, err
+}
+
+func (r *TagRepo) GetListByTitles(ctx context.Context, titles []string, userID string) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0)
+
+	query := `
+		SELECT id, user_id, created_at, title, COALESCE(color, '') AS color
+		FROM tags
+		WHERE lower(title) = ANY(SELECT lower(title) FROM unnest($1::text[]) AS title) AND user_id = $2
+		ORDER BY title;`
+	err := conn(ctx, r.db).SelectContext(ctx, &tags, query, pq.Array(titles), userID)
+
+	return tags, err
+}
+
+func (r *TagRepo) GetListByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]domain.Tag, error) {
+	rows := make([]struct {
+		TaskID string `db:"task_id"`
+		domain.Tag
+	}, 0)
+
+	query := `
+		SELECT tt.task_id, g.id, g.user_id, g.created_at, g.title, COALESCE(g.color, '') AS color
+		FROM task_tags tt
+		INNER JOIN tags g ON tt.tag_id = g.id
+		WHERE tt.task_id = ANY($1)
+		ORDER BY g.title;`
+	err := conn(ctx, r.db).SelectContext(ctx, &rows, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string][]domain.Tag, len(taskIDs))
+	for _, row := range rows {
+		tags[row.TaskID] = append(tags[row.TaskID], row.Tag)
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepo) SetTaskTags(ctx context.Context, taskID string, tagIDs []string) error {
+	return inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		query := "DELETE FROM task_tags WHERE task_id = $1;"
+		if _, err := tx.ExecContext(ctx, query, taskID); err != nil {
+			return err
+		}
+
+		if len(tagIDs) == 0 {
+			return nil
+		}
+		query = `
+			INSERT INTO task_tags (task_id, tag_id)
+			SELECT $1, tag_id FROM unnest($2::uuid[]) AS tag_id
+			ON CONFLICT DO NOTHING;`
+		_, err := tx.ExecContext(ctx, query, taskID, pq.Array(tagIDs))
+
+		return err
+	})
+}
@@ -13,6 +13,14 @@ import (
 	customErrors "todo_list_go/pkg/errors"
 )
 
+const insertTaskQuery = `
+		INSERT INTO tasks (
+			created_at, updated_at, user_id, category_id, title, description, status, priority,
+			due_date, due_time, due_timezone, due_at, recurrence_rule, series_id, position, estimate_minutes
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING id;`
+
 type TaskRepo struct {
 	db *sqlx.DB
 }
@@ -25,12 +33,10 @@ func (r *TaskRepo) Create(ctx context.Context, task domain.Task) (TaskOutput, er
 	var createdTaskID string
 	var createdTask TaskOutput
 
-	query := `
-		INSERT INTO tasks (created_at, updated_at, user_id, category_id, title, description, completed)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id;`
-	err := r.db.QueryRowxContext(
-		ctx, query, task.CreatedAt, task.UpdatedAt, task.UserID, task.CategoryID, task.Title, task.Description, task.Completed,
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, insertTaskQuery, task.CreatedAt, task.UpdatedAt, task.UserID, task.CategoryID, task.Title, task.Description, task.Status,
+		task.Priority, task.DueDate, task.DueTime, task.DueTimezone, task.DueAt, task.RecurrenceRule, task.SeriesID, task.Position,
+		task.EstimateMinutes,
 	).Scan(&createdTaskID)
 	if err != nil {
 		if customErrors.IsDuplicateDBError(err) {
@@ -39,24 +45,8 @@ func (r *TaskRepo) Create(ctx context.Context, task domain.Task) (TaskOutput, er
 		return TaskOutput{}, err
 	}
 
-	query = `
-		SELECT     
-		t.id AS id,
-		t.created_at AS created_at,
-		t.updated_at AS updated_at,
-		t.title AS title,
-		t.description AS description,
-		t.completed AS completed,
-		
-		c.id AS "category.id",
-		c.created_at AS "category.created_at",
-		c.title AS "category.title",
-		c.description AS "category.description",
-		c.color AS "category.color"
-		FROM tasks t
-		INNER JOIN categories c ON t.category_id = c.id 
-		WHERE t.id = $1;`
-	err = r.db.QueryRowxContext(ctx, query, createdTaskID).StructScan(&createdTask)
+	query := selectTaskQuery + " WHERE t.id = $1;"
+	err = conn(ctx, r.db).QueryRowxContext(ctx, query, createdTaskID).StructScan(&createdTask)
 	if err != nil {
 		return TaskOutput{}, err
 	}
@@ -64,9 +54,115 @@ func (r *TaskRepo) Create(ctx context.Context, task domain.Task) (TaskOutput, er
 	return createdTask, nil
 }
 
+func (r *TaskRepo) CreateList(ctx context.Context, inps []CreateTaskInput) ([]TaskOutput, error) {
+	createdTaskIDs := make([]string, len(inps))
+	err := inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		for i, inp := range inps {
+			task := inp.Task
+			err := tx.QueryRowxContext(
+				ctx, insertTaskQuery, task.CreatedAt, task.UpdatedAt, task.UserID, task.CategoryID, task.Title, task.Description,
+				task.Status, task.Priority, task.DueDate, task.DueTime, task.DueTimezone, task.DueAt, task.RecurrenceRule,
+				task.SeriesID, task.Position, task.EstimateMinutes,
+			).Scan(&createdTaskIDs[i])
+			if err != nil {
+				if customErrors.IsDuplicateDBError(err) {
+					return customErrors.ErrTaskAlreadyExists
+				}
+				return err
+			}
+
+			if len(inp.ChecklistItems) > 0 {
+				query := `
+					INSERT INTO checklist_items (created_at, updated_at, task_id, title, completed, position)
+					SELECT $1, $1, $2, item.title, FALSE, item.position - 1
+					FROM unnest($3::text[]) WITH ORDINALITY AS item(title, position);`
+				_, err := tx.ExecContext(ctx, query, task.CreatedAt, createdTaskIDs[i], pq.Array(inp.ChecklistItems))
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(inp.TagIDs) > 0 {
+				query := `
+					INSERT INTO task_tags (task_id, tag_id)
+					SELECT $1, tag_id FROM unnest($2::uuid[]) AS tag_id
+					ON CONFLICT DO NOTHING;`
+				if _, err := tx.ExecContext(ctx, query, createdTaskIDs[i], pq.Array(inp.TagIDs)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createdTasks := make([]TaskOutput, 0, len(createdTaskIDs))
+	query := selectTaskQuery + " WHERE t.id = ANY($1::uuid[]) ORDER BY array_position($1::uuid[], t.id);"
+	err = conn(ctx, r.db).SelectContext(ctx, &createdTasks, query, pq.Array(createdTaskIDs))
+
+	return createdTasks, err
+}
+
 func (r *TaskRepo) Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput, error) {
 	var updatedTaskID string
 
+	query, args := updateTaskQuery(inp)
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, args...).Scan(&updatedTaskID)
+	if err != nil {
+		if isTaskPositionConflict(err) {
+			return TaskOutput{}, customErrors.ErrInvalidTaskMove
+		}
+		if customErrors.IsDuplicateDBError(err) {
+			return TaskOutput{}, customErrors.ErrTaskAlreadyExists
+		}
+		return TaskOutput{}, err
+	}
+
+	return r.GetByID(ctx, updatedTaskID, inp.UserID)
+}
+
+func (r *TaskRepo) BulkUpdate(
+	ctx context.Context, updates []UpdateTaskInput, nextOccurrences map[string]domain.Task,
+) (map[string]string, error) {
+	createdIDs := make(map[string]string, len(nextOccurrences))
+	err := inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		for _, inp := range updates {
+			var updatedTaskID string
+
+			query, args := updateTaskQuery(inp)
+			err := tx.QueryRowxContext(ctx, query, args...).Scan(&updatedTaskID)
+			if err != nil {
+				if isTaskPositionConflict(err) {
+					return customErrors.ErrInvalidTaskMove
+				}
+				if customErrors.IsDuplicateDBError(err) {
+					return customErrors.ErrTaskAlreadyExists
+				}
+				return err
+			}
+
+			if next, ok := nextOccurrences[inp.ID]; ok {
+				createdID, err := createNextOccurrence(ctx, tx, inp.ID, next)
+				if err != nil {
+					return err
+				}
+				createdIDs[inp.ID] = createdID
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return createdIDs, nil
+}
+
+func updateTaskQuery(inp UpdateTaskInput) (string, []any) {
 	setClause := make([]string, 0)
 	args := make([]interface{}, 0)
 	argID := 1
@@ -89,104 +185,126 @@ func (r *TaskRepo) Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput,
 		args = append(args, inp.CategoryID)
 		argID++
 	}
-	if inp.Completed != nil {
-		setClause = append(setClause, fmt.Sprintf("completed = $%d", argID))
-		args = append(args, inp.Completed)
+	if inp.Status != nil {
+		setClause = append(setClause, fmt.Sprintf("status = $%d", argID))
+		args = append(args, inp.Status)
+		argID++
+	}
+	if inp.Priority != nil {
+		setClause = append(setClause, fmt.Sprintf("priority = $%d", argID))
+		args = append(args, inp.Priority)
+		argID++
+	}
+	if inp.Due != nil {
+		setClause = append(
+			setClause,
+			fmt.Sprintf(
+				"due_date = $%d, due_time = $%d, due_timezone = $%d, due_at = $%d",
+				argID, argID+1, argID+2, argID+3,
+			),
+		)
+		args = append(args, inp.Due.Date, inp.Due.Time, inp.Due.Timezone, inp.Due.At)
+		argID += 4
+	}
+	if inp.Position != nil {
+		setClause = append(setClause, fmt.Sprintf("position = $%d", argID))
+		args = append(args, inp.Position)
+		argID++
+	}
+	if inp.EstimateMinutes != nil {
+		setClause = append(setClause, fmt.Sprintf("estimate_minutes = NULLIF($%d, 0)", argID))
+		args = append(args, inp.EstimateMinutes)
 		argID++
 	}
 
 	setQuery := strings.Join(setClause, ", ")
 	query := fmt.Sprintf("UPDATE tasks SET %s WHERE id = $%d RETURNING id;", setQuery, argID)
 	args = append(args, inp.ID)
-	err := r.db.QueryRowxContext(ctx, query, args...).Scan(&updatedTaskID)
+
+	return query, args
+}
+
+func (r *TaskRepo) Delete(ctx context.Context, id string, deletedAt time.Time) error {
+	query := "UPDATE tasks SET deleted_at = $1 WHERE id = $2;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, deletedAt, id)
+
+	return err
+}
+
+func (r *TaskRepo) BulkDelete(ctx context.Context, ids []string, deletedAt time.Time) error {
+	query := "UPDATE tasks SET deleted_at = $1 WHERE id = ANY($2::uuid[]);"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, deletedAt, pq.Array(ids))
+
+	return err
+}
+
+func (r *TaskRepo) Restore(ctx context.Context, id string) error {
+	query := "UPDATE tasks SET deleted_at = NULL WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		if customErrors.IsDuplicateDBError(err) {
-			return TaskOutput{}, customErrors.ErrTaskAlreadyExists
+			return customErrors.ErrTaskAlreadyExists
 		}
-		return TaskOutput{}, err
+		return err
 	}
 
-	return r.GetByID(ctx, updatedTaskID, inp.UserID)
+	return nil
 }
 
-func (r *TaskRepo) Delete(ctx context.Context, id string) error {
-	query := "DELETE FROM tasks WHERE id = $1;"
-	_, err := r.db.ExecContext(ctx, query, id)
+func (r *TaskRepo) SetArchived(ctx context.Context, id string, archivedAt *time.Time, updatedAt time.Time) error {
+	query := "UPDATE tasks SET archived_at = $1, updated_at = $2 WHERE id = $3;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, archivedAt, updatedAt, id)
 
 	return err
 }
 
+func (r *TaskRepo) ArchiveCompleted(ctx context.Context, categoryID string, archivedAt time.Time) (int64, error) {
+	query := `
+		UPDATE tasks SET archived_at = $1, updated_at = $1
+		WHERE category_id = $2 AND completed AND archived_at IS NULL AND deleted_at IS NULL;`
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, archivedAt, categoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *TaskRepo) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := "DELETE FROM tasks WHERE deleted_at < $1;"
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
 func (r *TaskRepo) GetListByUserID(ctx context.Context, userID string, query domain.GetTasksQuery) ([]TaskOutput, int64, error) {
 	tasks := make([]TaskOutput, 0)
 	var count int64
 
-	dbQueryArgs := []any{userID}
-	whereParts := make([]string, 0)
-	whereArgIndex := 1
+	listQuery, err := newTaskListQuery(userID, query)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	whereParts = append(whereParts, fmt.Sprintf("t.user_id = $%d", whereArgIndex))
-	whereArgIndex++
+	orderBy, err := taskOrderBy(query.SortFields())
+	if err != nil {
+		return nil, 0, err
+	}
 
-	if query.CreatedAtDateFrom != "" {
-		dateFrom, err := time.Parse(time.DateOnly, query.CreatedAtDateFrom)
-		if err != nil {
-			return nil, 0, err
-		}
-		whereParts = append(whereParts, fmt.Sprintf("t.created_at >= $%d", whereArgIndex))
-		dbQueryArgs = append(dbQueryArgs, dateFrom)
-		whereArgIndex++
-	}
-	if query.CreatedAtDateTo != "" {
-		dateTo, err := time.Parse(time.DateOnly, query.CreatedAtDateTo)
-		if err != nil {
-			return nil, 0, err
-		}
-		whereParts = append(whereParts, fmt.Sprintf("t.created_at <= $%d", whereArgIndex))
-		dbQueryArgs = append(dbQueryArgs, dateTo)
-		whereArgIndex++
-	}
-	if query.Completed != nil {
-		whereParts = append(whereParts, fmt.Sprintf("t.completed = $%d", whereArgIndex))
-		dbQueryArgs = append(dbQueryArgs, *query.Completed)
-		whereArgIndex++
-	}
-	if len(query.CategoryIDs) > 0 {
-		whereParts = append(whereParts, fmt.Sprintf("t.category_id = ANY($%d)", whereArgIndex))
-		dbQueryArgs = append(dbQueryArgs, pq.Array(query.CategoryIDs))
-		whereArgIndex++
-	}
-
-	whereClause := strings.Join(whereParts, " AND ")
-	limitArgIndex, offsetArgIndex := whereArgIndex, whereArgIndex+1
-	dbQueryArgs = append(dbQueryArgs, query.Limit, query.Offset)
-
-	dbQuery := fmt.Sprintf(`
-		SELECT     
-		t.id AS id,
-		t.created_at AS created_at,
-		t.updated_at AS updated_at,
-		t.title AS title,
-		t.description AS description,
-		t.completed AS completed,
-		
-		c.id AS "category.id",
-		c.created_at AS "category.created_at",
-		c.title AS "category.title",
-		c.description AS "category.description",
-		c.color AS "category.color"
-		FROM tasks t
-		INNER JOIN categories c ON t.category_id = c.id 
-		WHERE %s
-		ORDER BY t.created_at DESC
-		LIMIT $%d OFFSET $%d;`, whereClause, limitArgIndex, offsetArgIndex)
-	err := r.db.SelectContext(ctx, &tasks, dbQuery, dbQueryArgs...)
+	dbQueryCountArgs := listQuery.args // exclude LIMIT and OFFSET
+	dbQueryCount := listQuery.countQuery()
+
+	limitOffset := fmt.Sprintf("LIMIT %s OFFSET %s", listQuery.arg(query.Limit), listQuery.arg(query.Offset))
+	dbQuery := listQuery.selectQuery(orderBy, limitOffset)
+	err = conn(ctx, r.db).SelectContext(ctx, &tasks, dbQuery, listQuery.args...)
 	if err != nil {
 		return tasks, 0, err
 	}
 
-	dbQueryCountArgs := dbQueryArgs[:whereArgIndex-1] // exclude LIMIT and OFFSET
-	dbQueryCount := fmt.Sprintf(`SELECT COUNT(*) FROM tasks t WHERE %s;`, whereClause)
-	err = r.db.QueryRowxContext(ctx, dbQueryCount, dbQueryCountArgs...).Scan(&count)
+	err = conn(ctx, r.db).QueryRowxContext(ctx, dbQueryCount, dbQueryCountArgs...).Scan(&count)
 	if err != nil {
 		return tasks, 0, err
 	}
@@ -194,28 +312,170 @@ func (r *TaskRepo) GetListByUserID(ctx context.Context, userID string, query dom
 	return tasks, count, err
 }
 
+// GetCursorListByUserID returns up to query.Limit tasks placed after the cursor in the requested sort order.
+// It uses keyset conditions instead of OFFSET and doesn't count tasks,
+// the returned cursor points to the last task and is nil when there are no more tasks.
+func (r *TaskRepo) GetCursorListByUserID(
+	ctx context.Context, userID string, query domain.GetTasksQuery, after *domain.Cursor,
+) ([]TaskOutput, *domain.Cursor, error) {
+	tasks := make([]TaskOutput, 0)
+	sortFields := query.SortFields()
+
+	listQuery, err := newTaskListQuery(userID, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if after != nil {
+		if err := listQuery.addKeysetCondition(sortFields, *after); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	orderBy, err := taskOrderBy(sortFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// fetch one extra task to find out whether there is a next page
+	dbQuery := listQuery.selectQuery(orderBy, "LIMIT "+listQuery.arg(query.Limit+1))
+	err = conn(ctx, r.db).SelectContext(ctx, &tasks, dbQuery, listQuery.args...)
+	if err != nil {
+		return tasks, nil, err
+	}
+
+	if len(tasks) <= query.Limit {
+		return tasks, nil, nil
+	}
+
+	tasks = tasks[:query.Limit]
+	lastTask := tasks[len(tasks)-1]
+	next := &domain.Cursor{
+		Sort:   query.SortKey(),
+		Values: make([]*string, len(sortFields)),
+		ID:     lastTask.ID,
+	}
+	for i, field := range sortFields {
+		next.Values[i] = taskSortValue(lastTask, field.Name)
+	}
+
+	return tasks, next, nil
+}
+
 func (r *TaskRepo) GetByID(ctx context.Context, taskID, userID string) (TaskOutput, error) {
 	var task TaskOutput
 
+	query := selectTaskQuery + " WHERE t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NULL;"
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, taskID, userID).StructScan(&task)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TaskOutput{}, customErrors.ErrTaskNotFound
+		}
+
+		return TaskOutput{}, err
+	}
+
+	return task, nil
+}
+
+func (r *TaskRepo) GetByIDForUpdate(ctx context.Context, taskID, userID string) (TaskOutput, error) {
+	var task TaskOutput
+
+	query := selectTaskQuery + " WHERE t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NULL FOR UPDATE OF t;"
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, taskID, userID).StructScan(&task)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TaskOutput{}, customErrors.ErrTaskNotFound
+		}
+
+		return TaskOutput{}, err
+	}
+
+	return task, nil
+}
+
+func (r *TaskRepo) GetListByIDs(ctx context.Context, taskIDs []string, userID string) ([]TaskOutput, error) {
+	tasks := make([]TaskOutput, 0)
+
+	query := selectTaskQuery + " WHERE t.id = ANY($1::uuid[]) AND t.user_id = $2 AND t.deleted_at IS NULL;"
+	err := conn(ctx, r.db).SelectContext(ctx, &tasks, query, pq.Array(taskIDs), userID)
+
+	return tasks, err
+}
+
+// LockPositions locks the category row, it doesn't block inserts of tasks referencing the category.
+func (r *TaskRepo) LockPositions(ctx context.Context, categoryID string) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, "SELECT id FROM categories WHERE id = $1 FOR NO KEY UPDATE;", categoryID)
+
+	return err
+}
+
+func (r *TaskRepo) GetLastPosition(ctx context.Context, categoryID string) (string, error) {
+	var position string
+
+	query := "SELECT COALESCE(MAX(position), '') FROM tasks WHERE category_id = $1;"
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, categoryID).Scan(&position)
+
+	return position, err
+}
+
+func (r *TaskRepo) GetFirstPositionBetween(ctx context.Context, categoryID, prev, next string) (string, error) {
+	var position string
+
 	query := `
-		SELECT     
-		t.id AS id,
-		t.created_at AS created_at,
-		t.updated_at AS updated_at,
-		t.title AS title,
-		t.description AS description,
-		t.completed AS completed,
-		
-		c.id AS "category.id",
-		c.created_at AS "category.created_at",
-		c.title AS "category.title",
-		c.description AS "category.description",
-		c.color AS "category.color"
-		FROM tasks t
-		INNER JOIN categories c ON t.category_id = c.id 
-		WHERE t.id = $1 AND t.user_id = $2;`
-
-	err := r.db.QueryRowxContext(ctx, query, taskID, userID).StructScan(&task)
+		SELECT COALESCE(MIN(position), '') FROM tasks
+		WHERE category_id = $1 AND position > $2 AND position < $3;`
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, categoryID, prev, next).Scan(&position)
+
+	return position, err
+}
+
+// RebalancePositions relies on the position constraint being checked at the end of the statement.
+func (r *TaskRepo) RebalancePositions(ctx context.Context, categoryID string) error {
+	query := `
+		UPDATE tasks t
+		SET position = p.position
+		FROM (
+			SELECT id, lpad(row_number() OVER (ORDER BY position, id)::text, 10, '0') || 'V' AS position
+			FROM tasks
+			WHERE category_id = $1
+		) p
+		WHERE t.id = p.id;`
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, categoryID)
+
+	return err
+}
+
+// isTaskPositionConflict reports whether the error violates uniqueness of task positions within a category.
+func isTaskPositionConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Constraint == "unique_category_task_position"
+}
+
+func (r *TaskRepo) GetIDsByFilters(ctx context.Context, userID string, filters domain.TaskFiltersQuery, limit int) ([]string, error) {
+	taskIDs := make([]string, 0)
+
+	listQuery, err := newTaskListQuery(userID, domain.GetTasksQuery{TaskFiltersQuery: filters})
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(
+		"SELECT t.id %s WHERE %s ORDER BY t.created_at DESC, t.id LIMIT %s;",
+		listQuery.fromClause(), listQuery.whereClause(), listQuery.arg(limit),
+	)
+	err = conn(ctx, r.db).SelectContext(ctx, &taskIDs, query, listQuery.args...)
+
+	return taskIDs, err
+}
+
+func (r *TaskRepo) GetDeletedByID(ctx context.Context, taskID, userID string) (TaskOutput, error) {
+	var task TaskOutput
+
+	query := selectTaskQuery + " WHERE t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NOT NULL;"
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, taskID, userID).StructScan(&task)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return TaskOutput{}, customErrors.ErrTaskNotFound
@@ -226,3 +486,98 @@ func (r *TaskRepo) GetByID(ctx context.Context, taskID, userID string) (TaskOutp
 
 	return task, nil
 }
+
+func (r *TaskRepo) GetDeletedListByUserID(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) ([]TaskOutput, int64, error) {
+	tasks := make([]TaskOutput, 0)
+
+	var count int64
+	countQuery := "SELECT COUNT(*) FROM tasks WHERE user_id = $1 AND deleted_at IS NOT NULL;"
+	if err := conn(ctx, r.db).GetContext(ctx, &count, countQuery, userID); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := selectTaskQuery + `
+		WHERE t.user_id = $1 AND t.deleted_at IS NOT NULL
+		ORDER BY t.deleted_at DESC, t.id
+		LIMIT $2 OFFSET $3;`
+	err := conn(ctx, r.db).SelectContext(ctx, &tasks, listQuery, userID, query.Limit, query.Offset)
+
+	return tasks, count, err
+}
+
+func (r *TaskRepo) UpdateRecurrence(ctx context.Context, inp UpdateTaskRecurrenceInput) error {
+	query := "UPDATE tasks SET updated_at = $1, series_id = $2, recurrence_rule = $3 WHERE id = $4;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, inp.UpdatedAt, inp.SeriesID, inp.RecurrenceRule, inp.ID)
+
+	return err
+}
+
+func (r *TaskRepo) GetRecurringBySeriesID(ctx context.Context, seriesID, userID string) (TaskOutput, error) {
+	var task TaskOutput
+
+	query := selectTaskQuery + `
+		WHERE t.series_id = $1 AND t.user_id = $2 AND t.recurrence_rule IS NOT NULL AND t.deleted_at IS NULL
+		ORDER BY t.created_at DESC
+		LIMIT 1;`
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, seriesID, userID).StructScan(&task)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TaskOutput{}, customErrors.ErrTaskNotFound
+		}
+
+		return TaskOutput{}, err
+	}
+
+	return task, nil
+}
+
+func (r *TaskRepo) CreateNextOccurrence(ctx context.Context, previousTaskID string, next domain.Task) (TaskOutput, error) {
+	var createdTaskID string
+	err := inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		var err error
+		createdTaskID, err = createNextOccurrence(ctx, tx, previousTaskID, next)
+		return err
+	})
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	return r.GetByID(ctx, createdTaskID, next.UserID)
+}
+
+func createNextOccurrence(ctx context.Context, tx *sqlx.Tx, previousTaskID string, next domain.Task) (string, error) {
+	var createdTaskID string
+
+	query := "UPDATE tasks SET recurrence_rule = NULL WHERE id = $1;"
+	if _, err := tx.ExecContext(ctx, query, previousTaskID); err != nil {
+		return "", err
+	}
+
+	err := tx.QueryRowxContext(
+		ctx, insertTaskQuery, next.CreatedAt, next.UpdatedAt, next.UserID, next.CategoryID, next.Title, next.Description, next.Status,
+		next.Priority, next.DueDate, next.DueTime, next.DueTimezone, next.DueAt, next.RecurrenceRule, next.SeriesID, next.Position,
+		next.EstimateMinutes,
+	).Scan(&createdTaskID)
+	if err != nil {
+		return "", err
+	}
+
+	query = `
+		INSERT INTO checklist_items (created_at, updated_at, task_id, title, completed, position)
+		SELECT $1, $1, $2, title, FALSE, position
+		FROM checklist_items
+		WHERE task_id = $3;`
+	if _, err := tx.ExecContext(ctx, query, next.CreatedAt, createdTaskID, previousTaskID); err != nil {
+		return "", err
+	}
+
+	query = "INSERT INTO task_tags (task_id, tag_id) SELECT $1, tag_id FROM task_tags WHERE task_id = $2;"
+	if _, err := tx.ExecContext(ctx, query, createdTaskID, previousTaskID); err != nil {
+		return "", err
+	}
+
+	return createdTaskID, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type TaskDependencyRepo struct {
+	db *sqlx.DB
+}
+
+func NewTaskDependencyRepo(db *sqlx.DB) *TaskDependencyRepo {
+	return &TaskDependencyRepo{db: db}
+}
+
+type taskDependencyRow struct {
+	TaskID    string `db:"task_id"`
+	BlockerID string `db:"blocker_id"`
+}
+
+func (r *TaskDependencyRepo) Create(ctx context.Context, taskID, blockerID string) error {
+	query := "INSERT INTO task_dependencies (task_id, blocker_id) VALUES ($1, $2);"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, taskID, blockerID)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return customErrors.ErrTaskDependencyAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *TaskDependencyRepo) LockByUserID(ctx context.Context, userID string) error {
+	query := "SELECT pg_advisory_xact_lock(hashtext('task_dependencies'), hashtext($1));"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, userID)
+
+	return err
+}
+
+func (r *TaskDependencyRepo) Delete(ctx context.Context, taskID, blockerID string) error {
+	query := "DELETE FROM task_dependencies WHERE task_id = $1 AND blocker_id = $2;"
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, taskID, blockerID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return customErrors.ErrTaskDependencyNotFound
+	}
+
+	return nil
+}
+
+func (r *TaskDependencyRepo) GetBlockersByUserID(ctx context.Context, userID string) (map[string][]string, error) {
+	rows := make([]taskDependencyRow, 0)
+
+	query := `
+		SELECT d.task_id, d.blocker_id
+		FROM task_dependencies d
+		INNER JOIN tasks t ON d.task_id = t.id
+		WHERE t.user_id = $1;`
+	err := conn(ctx, r.db).SelectContext(ctx, &rows, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blockers := make(map[string][]string)
+	for _, row := range rows {
+		blockers[row.TaskID] = append(blockers[row.TaskID], row.BlockerID)
+	}
+
+	return blockers, nil
+}
+
+// GetListByTaskIDs returns ids of the blocking tasks and ids of the blocked tasks by task id,
+// dependencies on tasks in the trash are skipped.
+func (r *TaskDependencyRepo) GetListByTaskIDs(
+	ctx context.Context, taskIDs []string,
+) (map[string][]string, map[string][]string, error) {
+	rows := make([]taskDependencyRow, 0)
+
+	query := `
+		SELECT d.task_id, d.blocker_id
+		FROM task_dependencies d
+		INNER JOIN tasks t ON d.task_id = t.id
+		INNER JOIN tasks b ON d.blocker_id = b.id
+		WHERE (d.task_id = ANY($1::uuid[]) OR d.blocker_id = ANY($1::uuid[]))
+			AND t.deleted_at IS NULL AND b.deleted_at IS NULL
+		ORDER BY d.created_at, d.task_id, d.blocker_id;`
+	err := conn(ctx, r.db).SelectContext(ctx, &rows, query, pq.Array(taskIDs))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockedBy := make(map[string][]string, len(taskIDs))
+	blocks := make(map[string][]string, len(taskIDs))
+	for _, row := range rows {
+		blockedBy[row.TaskID] = append(blockedBy[row.TaskID], row.BlockerID)
+		blocks[row.BlockerID] = append(blocks[row.BlockerID], row.TaskID)
+	}
+
+	return blockedBy, blocks, nil
+}
+
+func (r *TaskDependencyRepo) GetBlockedTaskIDs(ctx context.Context, taskIDs []string) ([]string, error) {
+	blockedTaskIDs := make([]string, 0)
+
+	query := `
+		SELECT DISTINCT d.task_id
+		FROM task_dependencies d
+		INNER JOIN tasks b ON d.blocker_id = b.id
+		WHERE d.task_id = ANY($1::uuid[]) AND NOT b.completed AND b.deleted_at IS NULL;`
+	err := conn(ctx, r.db).SelectContext(ctx, &blockedTaskIDs, query, pq.Array(taskIDs))
+
+	return blockedTaskIDs, err
+}
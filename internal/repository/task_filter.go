@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo_list_go/internal/domain"
+)
+
+// openBlockersCondition matches tasks blocked by tasks that are not completed.
+const openBlockersCondition = `EXISTS (
+			SELECT 1 FROM task_dependencies d
+			INNER JOIN tasks b ON d.blocker_id = b.id
+			WHERE d.task_id = t.id AND NOT b.completed AND b.deleted_at IS NULL)`
+
+const overdueCondition = "(t.completed = FALSE AND t.due_at IS NOT NULL AND t.due_at < now())"
+
+type taskFilterDateColumn struct {
+	expr string
+	// date columns hold calendar dates, other columns are timestamps compared with days in the query time zone
+	date bool
+}
+
+// taskFilterDateColumns maps date fields of the filter expression to task columns.
+var taskFilterDateColumns = map[string]taskFilterDateColumn{
+	domain.TaskFilterCreated: {expr: "t.created_at"},
+	domain.TaskFilterUpdated: {expr: "t.updated_at"},
+	domain.TaskFilterDue:     {expr: "t.due_date", date: true},
+}
+
+// addFilterCondition parses the filter expression and adds its condition.
+func (q *taskListQuery) addFilterCondition(expr string) error {
+	filter, err := domain.ParseTaskFilter(expr)
+	if err != nil {
+		return err
+	}
+
+	condition, err := q.filterCondition(filter)
+	if err != nil {
+		return err
+	}
+	q.where = append(q.where, condition)
+
+	return nil
+}
+
+// filterCondition compiles the filter to an SQL condition, values are passed as arguments.
+// Conditions never evaluate to NULL, so NOT matches exactly the tasks the condition doesn't match.
+func (q *taskListQuery) filterCondition(filter domain.TaskFilter) (string, error) {
+	switch f := filter.(type) {
+	case domain.TaskFilterAnd:
+		return q.binaryFilterCondition(f.Left, f.Right, "AND")
+	case domain.TaskFilterOr:
+		return q.binaryFilterCondition(f.Left, f.Right, "OR")
+	case domain.TaskFilterNot:
+		operand, err := q.filterCondition(f.Operand)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + operand, nil
+	case domain.TaskFilterCondition:
+		condition, err := q.fieldCondition(f)
+		if err != nil {
+			return "", err
+		}
+		return "(" + condition + ")", nil
+	default:
+		return "", fmt.Errorf("unsupported filter node %T", filter)
+	}
+}
+
+func (q *taskListQuery) binaryFilterCondition(left, right domain.TaskFilter, operator string) (string, error) {
+	leftCondition, err := q.filterCondition(left)
+	if err != nil {
+		return "", err
+	}
+	rightCondition, err := q.filterCondition(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftCondition, operator, rightCondition), nil
+}
+
+func (q *taskListQuery) fieldCondition(c domain.TaskFilterCondition) (string, error) {
+	switch c.Field {
+	case domain.TaskFilterCategory:
+		return fmt.Sprintf("lower(c.title) %s lower(%s)", sqlOperator(c.Operator), q.arg(c.Value)), nil
+	case domain.TaskFilterTag:
+		condition := fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM task_tags tt
+			INNER JOIN tags g ON tt.tag_id = g.id
+			WHERE tt.task_id = t.id AND lower(g.title) = lower(%s))`, q.arg(c.Value))
+		return negateIf(condition, c.Operator == domain.TaskFilterNotEqual), nil
+	case domain.TaskFilterTitle:
+		if c.Operator == domain.TaskFilterContains {
+			return "t.title ILIKE " + q.arg("%"+escapeLikePattern(c.Value)+"%"), nil
+		}
+		return fmt.Sprintf("t.title %s %s", sqlOperator(c.Operator), q.arg(c.Value)), nil
+	case domain.TaskFilterStatus:
+		status, err := domain.ParseTaskStatus(c.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("t.status %s %s", sqlOperator(c.Operator), q.arg(int64(status))), nil
+	case domain.TaskFilterPriority:
+		priority, err := domain.ParseTaskPriority(c.Value)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("t.priority %s %s", sqlOperator(c.Operator), q.arg(int64(priority))), nil
+	case domain.TaskFilterCompleted:
+		return fmt.Sprintf("t.completed %s %s", sqlOperator(c.Operator), q.arg(c.Value == "true")), nil
+	case domain.TaskFilterOverdue:
+		return negateIf(overdueCondition, (c.Value == "true") != (c.Operator == domain.TaskFilterEqual)), nil
+	case domain.TaskFilterBlocked:
+		return negateIf(openBlockersCondition, (c.Value == "true") != (c.Operator == domain.TaskFilterEqual)), nil
+	case domain.TaskFilterCreated, domain.TaskFilterUpdated, domain.TaskFilterDue:
+		return q.dateCondition(taskFilterDateColumns[c.Field], c)
+	default:
+		return "", fmt.Errorf("unsupported filter field: %s", c.Field)
+	}
+}
+
+// dateCondition compares the column with whole days, so created=2025-01-01 matches the entire day.
+func (q *taskListQuery) dateCondition(dateColumn taskFilterDateColumn, c domain.TaskFilterCondition) (string, error) {
+	column := dateColumn.expr
+	day, err := time.ParseInLocation(time.DateOnly, c.Value, q.location)
+	if err != nil {
+		return "", err
+	}
+	dayStart := func() string { return q.arg(day) }
+	nextDayStart := func() string { return q.arg(day.AddDate(0, 0, 1)) }
+	if dateColumn.date {
+		dayStart = func() string { return q.arg(c.Value) + "::date" }
+		nextDayStart = func() string { return q.arg(c.Value) + "::date + 1" }
+	}
+
+	var condition string
+	switch c.Operator {
+	case domain.TaskFilterEqual:
+		condition = fmt.Sprintf("%[1]s >= %[2]s AND %[1]s < %[3]s", column, dayStart(), nextDayStart())
+	case domain.TaskFilterNotEqual:
+		condition = fmt.Sprintf("(%[1]s < %[2]s OR %[1]s >= %[3]s)", column, dayStart(), nextDayStart())
+	case domain.TaskFilterLess:
+		condition = fmt.Sprintf("%s < %s", column, dayStart())
+	case domain.TaskFilterLessEqual:
+		condition = fmt.Sprintf("%s < %s", column, nextDayStart())
+	case domain.TaskFilterGreater:
+		condition = fmt.Sprintf("%s >= %s", column, nextDayStart())
+	case domain.TaskFilterGreaterEqual:
+		condition = fmt.Sprintf("%s >= %s", column, dayStart())
+	default:
+		return "", fmt.Errorf("unsupported date filter operator: %s", c.Operator)
+	}
+
+	// tasks without a due date match no date condition
+	return fmt.Sprintf("%s IS NOT NULL AND %s", column, condition), nil
+}
+
+// sqlOperator returns the SQL comparison operator of the filter operator.
+func sqlOperator(operator string) string {
+	if operator == domain.TaskFilterNotEqual {
+		return "<>"
+	}
+	return operator
+}
+
+func negateIf(condition string, negate bool) string {
+	if negate {
+		return "NOT " + condition
+	}
+	return condition
+}
+
+// escapeLikePattern escapes wildcard characters of a LIKE pattern.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
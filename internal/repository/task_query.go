@@ -0,0 +1,380 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"strconv"
+	"strings"
+	"time"
+	"todo_list_go/internal/domain"
+)
+
+// searchConfig is the PostgreSQL text search configuration used for tasks.search_vector.
+const searchConfig = "english"
+
+// titles are highlighted as a whole, descriptions are cut to snippets around the matches,
+// both are HTML-escaped before highlighting, so <mark> is the only markup of a highlight
+const (
+	searchTitleHighlightOptions       = "StartSel=<mark>, StopSel=</mark>, HighlightAll=TRUE"
+	searchDescriptionHighlightOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5"
+)
+
+const taskColumns = `
+		t.id AS id,
+		t.created_at AS created_at,
+		t.updated_at AS updated_at,
+		t.title AS title,
+		t.description AS description,
+		t.completed AS completed,
+		t.status AS status,
+		t.priority AS priority,
+		t.due_date AS due_date,
+		to_char(t.due_time, 'HH24:MI') AS due_time,
+		t.due_timezone AS due_timezone,
+		t.due_at AS due_at,
+		t.estimate_minutes AS estimate_minutes,
+		t.recurrence_rule AS recurrence_rule,
+		t.series_id AS series_id,
+		t.position AS position,
+		t.archived_at AS archived_at,
+		t.deleted_at AS deleted_at,
+		(SELECT COUNT(*) FROM checklist_items i WHERE i.task_id = t.id AND i.completed) AS checklist_done,
+		(SELECT COUNT(*) FROM checklist_items i WHERE i.task_id = t.id) AS checklist_total,
+		(
+			SELECT COALESCE(SUM(EXTRACT(EPOCH FROM COALESCE(e.ended_at, now()) - e.started_at)), 0)::bigint / 60
+			FROM time_entries e WHERE e.task_id = t.id
+		) AS tracked_minutes,
+		(SELECT COUNT(*) FROM task_comments m WHERE m.task_id = t.id) AS comments_count,
+
+		c.id AS "category.id",
+		c.created_at AS "category.created_at",
+		c.title AS "category.title",
+		c.description AS "category.description",
+		c.color AS "category.color",
+		c.deleted_at AS "category.deleted_at"`
+
+const taskTables = `
+		FROM tasks t
+		INNER JOIN categories c ON t.category_id = c.id`
+
+const selectTaskQuery = "SELECT" + taskColumns + taskTables
+
+type taskSortColumn struct {
+	expr     string
+	nullable bool
+}
+
+// taskSortColumns maps sortable fields of domain.GetTasksQuery to SQL expressions.
+var taskSortColumns = map[string]taskSortColumn{
+	domain.TaskSortCreatedAt:     {expr: "t.created_at"},
+	domain.TaskSortUpdatedAt:     {expr: "t.updated_at"},
+	domain.TaskSortTitle:         {expr: "t.title"},
+	domain.TaskSortPriority:      {expr: "t.priority"},
+	domain.TaskSortDueDate:       {expr: "t.due_at", nullable: true},
+	domain.TaskSortCategoryTitle: {expr: "c.title"},
+	domain.TaskSortRelevance:     {expr: "ts_rank(t.search_vector, search.query)"},
+	domain.TaskSortPosition:      {expr: "t.position"},
+}
+
+// taskListQuery collects parts of the task list query.
+// Placeholders are numbered in the order their arguments are added.
+type taskListQuery struct {
+	columns  []string
+	joins    []string
+	where    []string
+	args     []any
+	location *time.Location
+}
+
+// newTaskListQuery builds the task list query with conditions for the query filters.
+func newTaskListQuery(userID string, query domain.GetTasksQuery) (*taskListQuery, error) {
+	location, err := query.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	q := &taskListQuery{location: location}
+	q.where = append(q.where, "t.user_id = "+q.arg(userID), "t.deleted_at IS NULL")
+
+	if err := q.addDayRangeCondition("t.created_at", query.CreatedAtDateFrom, query.CreatedAtDateTo); err != nil {
+		return nil, err
+	}
+	if err := q.addDayRangeCondition("t.updated_at", query.UpdatedAtDateFrom, query.UpdatedAtDateTo); err != nil {
+		return nil, err
+	}
+	if query.Completed != nil {
+		q.where = append(q.where, "t.completed = "+q.arg(*query.Completed))
+	}
+	if query.Archived != nil && *query.Archived {
+		q.where = append(q.where, "t.archived_at IS NOT NULL")
+	} else {
+		q.where = append(q.where, "t.archived_at IS NULL")
+	}
+	if len(query.CategoryIDs) > 0 {
+		q.where = append(q.where, fmt.Sprintf("t.category_id = ANY(%s)", q.arg(pq.Array(query.CategoryIDs))))
+	}
+	// due dates are calendar dates, so they are compared as dates independently of the time zone
+	if query.DueDateFrom != "" {
+		if _, err := time.Parse(time.DateOnly, query.DueDateFrom); err != nil {
+			return nil, err
+		}
+		q.where = append(q.where, fmt.Sprintf("t.due_date >= %s::date", q.arg(query.DueDateFrom)))
+	}
+	if query.DueDateTo != "" {
+		if _, err := time.Parse(time.DateOnly, query.DueDateTo); err != nil {
+			return nil, err
+		}
+		q.where = append(q.where, fmt.Sprintf("t.due_date <= %s::date", q.arg(query.DueDateTo)))
+	}
+	if query.Overdue != nil {
+		if *query.Overdue {
+			q.where = append(q.where, "(t.completed = FALSE AND t.due_at < now())")
+		} else {
+			q.where = append(q.where, "(t.completed = TRUE OR t.due_at IS NULL OR t.due_at >= now())")
+		}
+	}
+	if len(query.Priorities) > 0 {
+		priorities := make([]int64, len(query.Priorities))
+		for i := range query.Priorities {
+			priority, err := domain.ParseTaskPriority(query.Priorities[i])
+			if err != nil {
+				return nil, err
+			}
+			priorities[i] = int64(priority)
+		}
+		q.where = append(q.where, fmt.Sprintf("t.priority = ANY(%s)", q.arg(pq.Array(priorities))))
+	}
+	if len(query.Statuses) > 0 {
+		statuses := make([]int64, len(query.Statuses))
+		for i := range query.Statuses {
+			status, err := domain.ParseTaskStatus(query.Statuses[i])
+			if err != nil {
+				return nil, err
+			}
+			statuses[i] = int64(status)
+		}
+		q.where = append(q.where, fmt.Sprintf("t.status = ANY(%s)", q.arg(pq.Array(statuses))))
+	}
+	if query.Blocked != nil {
+		q.where = append(q.where, negateIf(openBlockersCondition, !*query.Blocked))
+	}
+	if len(query.TagIDs) > 0 || len(query.Tags) > 0 {
+		q.addTagsCondition(query.TagIDs, query.Tags, query.TagMatch)
+	}
+	if query.Filter != "" {
+		if err := q.addFilterCondition(query.Filter); err != nil {
+			return nil, err
+		}
+	}
+	if query.Q != "" {
+		q.joins = append(
+			q.joins,
+			fmt.Sprintf("CROSS JOIN websearch_to_tsquery('%s', %s) AS search(query)", searchConfig, q.arg(query.Q)),
+		)
+		q.where = append(q.where, "t.search_vector @@ search.query")
+		q.columns = append(q.columns, "ts_rank(t.search_vector, search.query) AS search_rank")
+		if query.Highlight {
+			q.columns = append(
+				q.columns,
+				fmt.Sprintf(
+					"ts_headline('%s', %s, search.query, '%s') AS title_highlight",
+					searchConfig, escapeHTMLExpr("t.title"), searchTitleHighlightOptions,
+				),
+				fmt.Sprintf(
+					"ts_headline('%s', %s, search.query, '%s') AS description_highlight",
+					searchConfig, escapeHTMLExpr("COALESCE(t.description, '')"), searchDescriptionHighlightOptions,
+				),
+			)
+		}
+	}
+
+	return q, nil
+}
+
+// escapeHTMLExpr wraps the SQL text expression to escape HTML special characters the way html.EscapeString does.
+func escapeHTMLExpr(expr string) string {
+	for _, r := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&#34;"}, {"''", "&#39;"}} {
+		expr = fmt.Sprintf("replace(%s, '%s', '%s')", expr, r[0], r[1])
+	}
+	return expr
+}
+
+// addDayRangeCondition matches timestamps from the start of the from day to the end of the to day
+// in the query time zone, empty bounds are open.
+func (q *taskListQuery) addDayRangeCondition(column, from, to string) error {
+	if from != "" {
+		dayStart, err := time.ParseInLocation(time.DateOnly, from, q.location)
+		if err != nil {
+			return err
+		}
+		q.where = append(q.where, fmt.Sprintf("%s >= %s", column, q.arg(dayStart)))
+	}
+	if to != "" {
+		dayStart, err := time.ParseInLocation(time.DateOnly, to, q.location)
+		if err != nil {
+			return err
+		}
+		q.where = append(q.where, fmt.Sprintf("%s < %s", column, q.arg(dayStart.AddDate(0, 0, 1))))
+	}
+
+	return nil
+}
+
+// addTagsCondition matches tasks having any or all of the tags given by ids and titles.
+func (q *taskListQuery) addTagsCondition(tagIDs, tagTitles []string, match string) {
+	conditions := make([]string, 0, 2)
+	counts := make([]int, 0, 2)
+	if len(tagIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("g.id = ANY(%s::uuid[])", q.arg(pq.Array(tagIDs))))
+		counts = append(counts, len(tagIDs))
+	}
+	if len(tagTitles) > 0 {
+		conditions = append(conditions, fmt.Sprintf("g.title = ANY(%s)", q.arg(pq.Array(tagTitles))))
+		counts = append(counts, len(tagTitles))
+	}
+
+	const taskTags = `
+		FROM task_tags tt
+		INNER JOIN tags g ON tt.tag_id = g.id
+		WHERE tt.task_id = t.id AND `
+
+	if match == domain.TagMatchAll {
+		// tag ids and titles are unique, so the task has all tags when it has as many matching tags as requested
+		for i, condition := range conditions {
+			q.where = append(q.where, fmt.Sprintf("(SELECT COUNT(*) %s%s) = %s", taskTags, condition, q.arg(counts[i])))
+		}
+		return
+	}
+
+	q.where = append(q.where, fmt.Sprintf("EXISTS (SELECT 1 %s(%s))", taskTags, strings.Join(conditions, " OR ")))
+}
+
+// arg adds the query argument and returns its placeholder.
+func (q *taskListQuery) arg(value any) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *taskListQuery) fromClause() string {
+	return taskTables + "\n\t\t" + strings.Join(q.joins, "\n\t\t")
+}
+
+func (q *taskListQuery) whereClause() string {
+	return strings.Join(q.where, " AND ")
+}
+
+func (q *taskListQuery) selectQuery(orderBy, limitOffset string) string {
+	columns := taskColumns
+	if len(q.columns) > 0 {
+		columns += ",\n\t\t" + strings.Join(q.columns, ",\n\t\t")
+	}
+
+	return fmt.Sprintf(`SELECT %s %s
+		WHERE %s
+		ORDER BY %s
+		%s;`, columns, q.fromClause(), q.whereClause(), orderBy, limitOffset)
+}
+
+func (q *taskListQuery) countQuery() string {
+	return fmt.Sprintf("SELECT COUNT(*) %s WHERE %s;", q.fromClause(), q.whereClause())
+}
+
+// taskOrderBy builds ORDER BY clause, task id is always added last to make the order stable.
+func taskOrderBy(fields []domain.SortField) (string, error) {
+	orderParts := make([]string, 0, len(fields)+1)
+	for _, field := range fields {
+		column, ok := taskSortColumns[field.Name]
+		if !ok {
+			return "", fmt.Errorf("unsupported sort field: %s", field.Name)
+		}
+
+		direction := "ASC"
+		if field.Descending {
+			direction = "DESC"
+		}
+		orderParts = append(orderParts, fmt.Sprintf("%s %s NULLS LAST", column.expr, direction))
+	}
+	orderParts = append(orderParts, "t.id ASC")
+
+	return strings.Join(orderParts, ", "), nil
+}
+
+// addKeysetCondition adds a condition that matches tasks placed after the cursor in the order built by taskOrderBy:
+// (a > $1) OR (a = $1 AND b < $2) OR (a = $1 AND b = $2 AND t.id > $3) for `a ASC, b DESC, t.id ASC`.
+// Cursor values are passed as text parameters, PostgreSQL casts them to the column types.
+func (q *taskListQuery) addKeysetCondition(fields []domain.SortField, cursor domain.Cursor) error {
+	if len(cursor.Values) != len(fields) {
+		return fmt.Errorf("cursor has %d values for %d sort fields", len(cursor.Values), len(fields))
+	}
+
+	equalParts := make([]string, 0, len(fields))
+	orParts := make([]string, 0, len(fields)+1)
+
+	for i, field := range fields {
+		column, ok := taskSortColumns[field.Name]
+		if !ok {
+			return fmt.Errorf("unsupported sort field: %s", field.Name)
+		}
+
+		value := cursor.Values[i]
+		if value == nil {
+			// NULLs go last, so only other NULLs may follow a NULL value
+			equalParts = append(equalParts, fmt.Sprintf("%s IS NULL", column.expr))
+			continue
+		}
+
+		operator := ">"
+		if field.Descending {
+			operator = "<"
+		}
+		placeholder := q.arg(*value)
+		afterPart := fmt.Sprintf("%s %s %s", column.expr, operator, placeholder)
+		if column.nullable {
+			afterPart = fmt.Sprintf("(%s OR %s IS NULL)", afterPart, column.expr)
+		}
+
+		orParts = append(orParts, joinConditions(append(equalParts, afterPart)))
+		equalParts = append(equalParts, fmt.Sprintf("%s = %s", column.expr, placeholder))
+	}
+
+	orParts = append(orParts, joinConditions(append(equalParts, "t.id > "+q.arg(cursor.ID))))
+	q.where = append(q.where, "("+strings.Join(orParts, " OR ")+")")
+
+	return nil
+}
+
+func joinConditions(conditions []string) string {
+	return "(" + strings.Join(conditions, " AND ") + ")"
+}
+
+// taskSortValue returns the task value of the sort field in the format accepted by PostgreSQL.
+func taskSortValue(task TaskOutput, field string) *string {
+	var value string
+	switch field {
+	case domain.TaskSortCreatedAt:
+		value = task.CreatedAt.Format(time.RFC3339Nano)
+	case domain.TaskSortUpdatedAt:
+		value = task.UpdatedAt.Format(time.RFC3339Nano)
+	case domain.TaskSortTitle:
+		value = task.Title
+	case domain.TaskSortPriority:
+		value = strconv.Itoa(int(task.Priority))
+	case domain.TaskSortDueDate:
+		if task.DueAt == nil {
+			return nil
+		}
+		value = task.DueAt.Format(time.RFC3339Nano)
+	case domain.TaskSortCategoryTitle:
+		value = task.Category.Title
+	case domain.TaskSortPosition:
+		value = task.Position
+	case domain.TaskSortRelevance:
+		if task.SearchRank == nil {
+			return nil
+		}
+		value = strconv.FormatFloat(*task.SearchRank, 'g', -1, 64)
+	default:
+		return nil
+	}
+	return &value
+}
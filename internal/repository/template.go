@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const templateColumns = "id, created_at, updated_at, user_id, title, description, tasks"
+
+type TemplateRepo struct {
+	db *sqlx.DB
+}
+
+func NewTemplateRepo(db *sqlx.DB) *TemplateRepo {
+	return &TemplateRepo{db: db}
+}
+
+func (r *TemplateRepo) Create(ctx context.Context, template domain.TaskTemplate) (domain.TaskTemplate, error) {
+	var createdTemplate domain.TaskTemplate
+
+	query := `
+		INSERT INTO task_templates (created_at, updated_at, user_id, title, description, tasks)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + templateColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, query, template.CreatedAt, template.UpdatedAt, template.UserID, template.Title, template.Description,
+		template.Tasks,
+	).StructScan(&createdTemplate)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.TaskTemplate{}, customErrors.ErrTemplateAlreadyExists
+		}
+		return domain.TaskTemplate{}, err
+	}
+
+	return createdTemplate, nil
+}
+
+func (r *TemplateRepo) Update(ctx context.Context, inp UpdateTemplateInput) (domain.TaskTemplate, error) {
+	var updatedTemplate domain.TaskTemplate
+
+	setClause := []string{"updated_at = $1"}
+	args := []interface{}{inp.UpdatedAt}
+	argID := 2
+
+	if inp.Title != nil {
+		setClause = append(setClause, fmt.Sprintf("title = $%d", argID))
+		args = append(args, inp.Title)
+		argID++
+	}
+	if inp.Description != nil {
+		setClause = append(setClause, fmt.Sprintf("description = $%d", argID))
+		args = append(args, inp.Description)
+		argID++
+	}
+	if inp.Tasks != nil {
+		setClause = append(setClause, fmt.Sprintf("tasks = $%d", argID))
+		args = append(args, inp.Tasks)
+		argID++
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE task_templates SET %s WHERE id = $%d RETURNING %s;",
+		strings.Join(setClause, ", "), argID, templateColumns,
+	)
+	args = append(args, inp.ID)
+
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, args...).StructScan(&updatedTemplate)
+	if err != nil {
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.TaskTemplate{}, customErrors.ErrTemplateAlreadyExists
+		}
+		return domain.TaskTemplate{}, err
+	}
+
+	return updatedTemplate, nil
+}
+
+func (r *TemplateRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM task_templates WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *TemplateRepo) GetByID(ctx context.Context, templateID, userID string) (domain.TaskTemplate, error) {
+	var template domain.TaskTemplate
+
+	query := "SELECT " + templateColumns + " FROM task_templates WHERE id = $1 AND user_id = $2;"
+	err := conn(ctx, r.db).GetContext(ctx, &template, query, templateID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TaskTemplate{}, customErrors.ErrTemplateNotFound
+		}
+		return domain.TaskTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func (r *TemplateRepo) GetListByUserID(ctx context.Context, userID string) ([]domain.TaskTemplate, error) {
+	templates := make([]domain.TaskTemplate, 0)
+
+	query := "SELECT " + templateColumns + " FROM task_templates WHERE user_id = $1 ORDER BY title;"
+	err := conn(ctx, r.db).SelectContext(ctx, &templates, query, userID)
+
+	return templates, err
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"time"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const timeEntryColumns = "id, created_at, user_id, task_id, started_at, ended_at"
+
+type TimeEntryRepo struct {
+	db *sqlx.DB
+}
+
+func NewTimeEntryRepo(db *sqlx.DB) *TimeEntryRepo {
+	return &TimeEntryRepo{db: db}
+}
+
+func (r *TimeEntryRepo) Create(ctx context.Context, entry domain.TimeEntry) (domain.TimeEntry, error) {
+	var createdEntry domain.TimeEntry
+
+	query := `
+		INSERT INTO time_entries (created_at, user_id, task_id, started_at, ended_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + timeEntryColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, query, entry.CreatedAt, entry.UserID, entry.TaskID, entry.StartedAt, entry.EndedAt,
+	).StructScan(&createdEntry)
+	if err != nil {
+		// only running timers are unique per user
+		if customErrors.IsDuplicateDBError(err) {
+			return domain.TimeEntry{}, customErrors.ErrTimerAlreadyRunning
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	return createdEntry, nil
+}
+
+func (r *TimeEntryRepo) Stop(ctx context.Context, id string, endedAt time.Time) (domain.TimeEntry, error) {
+	var stoppedEntry domain.TimeEntry
+
+	query := `
+		UPDATE time_entries SET ended_at = $1
+		WHERE id = $2 AND ended_at IS NULL
+		RETURNING ` + timeEntryColumns + ";"
+	err := conn(ctx, r.db).QueryRowxContext(ctx, query, endedAt, id).StructScan(&stoppedEntry)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TimeEntry{}, customErrors.ErrTimerNotRunning
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	return stoppedEntry, nil
+}
+
+func (r *TimeEntryRepo) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM time_entries WHERE id = $1;"
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *TimeEntryRepo) GetByID(ctx context.Context, entryID, taskID string) (domain.TimeEntry, error) {
+	var entry domain.TimeEntry
+
+	query := "SELECT " + timeEntryColumns + " FROM time_entries WHERE id = $1 AND task_id = $2;"
+	err := conn(ctx, r.db).GetContext(ctx, &entry, query, entryID, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TimeEntry{}, customErrors.ErrTimeEntryNotFound
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (r *TimeEntryRepo) GetRunningByUserID(ctx context.Context, userID string) (domain.TimeEntry, error) {
+	var entry domain.TimeEntry
+
+	query := "SELECT " + timeEntryColumns + " FROM time_entries WHERE user_id = $1 AND ended_at IS NULL;"
+	err := conn(ctx, r.db).GetContext(ctx, &entry, query, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TimeEntry{}, customErrors.ErrTimerNotRunning
+		}
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (r *TimeEntryRepo) GetListByTaskID(ctx context.Context, taskID string) ([]domain.TimeEntry, error) {
+	entries := make([]domain.TimeEntry, 0)
+
+	query := "SELECT " + timeEntryColumns + " FROM time_entries WHERE task_id = $1 ORDER BY started_at DESC;"
+	err := conn(ctx, r.db).SelectContext(ctx, &entries, query, taskID)
+
+	return entries, err
+}
+
+func (r *TimeEntryRepo) GetReport(
+	ctx context.Context, userID string, from, to time.Time, timezone string,
+) (domain.TimeReport, error) {
+	report := domain.TimeReport{
+		Categories: make([]domain.CategoryTime, 0),
+		Days:       make([]domain.DayTime, 0),
+	}
+
+	// running timers are counted up to now, entries of tasks in the trash are skipped
+	const entries = `
+		FROM time_entries e
+		INNER JOIN tasks t ON e.task_id = t.id
+		INNER JOIN categories c ON t.category_id = c.id
+		WHERE e.user_id = $1 AND e.started_at >= $2 AND e.started_at < $3 AND t.deleted_at IS NULL`
+	const seconds = "SUM(EXTRACT(EPOCH FROM COALESCE(e.ended_at, now()) - e.started_at))::bigint AS seconds"
+
+	query := "SELECT c.id AS category_id, c.title AS title, " + seconds + entries + `
+		GROUP BY c.id, c.title
+		ORDER BY seconds DESC, c.title;`
+	err := conn(ctx, r.db).SelectContext(ctx, &report.Categories, query, userID, from, to)
+	if err != nil {
+		return domain.TimeReport{}, err
+	}
+
+	query = "SELECT (e.started_at AT TIME ZONE $4)::date AS date, " + seconds + entries + `
+		GROUP BY 1
+		ORDER BY 1;`
+	err = conn(ctx, r.db).SelectContext(ctx, &report.Days, query, userID, from, to, timezone)
+	if err != nil {
+		return domain.TimeReport{}, err
+	}
+
+	return report, nil
+}
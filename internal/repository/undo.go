@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type UndoRepo struct {
+	db *sqlx.DB
+}
+
+func NewUndoRepo(db *sqlx.DB) *UndoRepo {
+	return &UndoRepo{db: db}
+}
+
+// Create removes expired operations of the user along the way.
+func (r *UndoRepo) Create(ctx context.Context, operation domain.UndoOperation) (string, error) {
+	var id string
+
+	query := `
+		WITH expired AS (DELETE FROM undo_operations WHERE user_id = $2 AND expires_at <= $1)
+		INSERT INTO undo_operations (created_at, expires_at, user_id, action, tasks)
+		VALUES ($1, $3, $2, $4, $5)
+		RETURNING id;`
+	err := conn(ctx, r.db).QueryRowxContext(
+		ctx, query, operation.CreatedAt, operation.UserID, operation.ExpiresAt, operation.Action, operation.Tasks,
+	).Scan(&id)
+
+	return id, err
+}
+
+func (r *UndoRepo) Redeem(ctx context.Context, id, userID string, undoneAt time.Time) (domain.UndoOperation, error) {
+	var operation domain.UndoOperation
+	err := inTransaction(ctx, r.db, func(tx *sqlx.Tx) error {
+		query := `
+			SELECT id, created_at, expires_at, user_id, action, tasks
+			FROM undo_operations
+			WHERE id = $1 AND user_id = $2 AND expires_at > $3
+			FOR UPDATE;`
+		err := tx.GetContext(ctx, &operation, query, id, userID, undoneAt)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return customErrors.ErrUndoTokenNotFound
+			}
+			return err
+		}
+
+		for _, snapshot := range operation.Tasks {
+			if err := restoreTaskSnapshot(ctx, tx, userID, snapshot, undoneAt); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM undo_operations WHERE id = $1;", id)
+
+		return err
+	})
+	if err != nil {
+		return domain.UndoOperation{}, err
+	}
+
+	return operation, nil
+}
+
+func restoreTaskSnapshot(
+	ctx context.Context, tx *sqlx.Tx, userID string, snapshot domain.TaskSnapshot, restoredAt time.Time,
+) error {
+	if err := lockTaskVersion(ctx, tx, userID, snapshot.After); err != nil {
+		return err
+	}
+	if snapshot.NextOccurrence != nil {
+		if err := lockTaskVersion(ctx, tx, userID, *snapshot.NextOccurrence); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tasks WHERE id = $1;", snapshot.NextOccurrence.ID); err != nil {
+			return err
+		}
+	}
+
+	var categoryExists bool
+	query := "SELECT EXISTS (SELECT 1 FROM categories WHERE id = $1 AND deleted_at IS NULL);"
+	if err := tx.GetContext(ctx, &categoryExists, query, snapshot.CategoryID); err != nil {
+		return err
+	}
+	if !categoryExists {
+		return customErrors.ErrTaskCategoryDeleted
+	}
+
+	query = `
+		UPDATE tasks
+		SET updated_at = $1, category_id = $2, title = $3, description = $4, status = $5, priority = $6,
+			due_date = $7, due_time = $8, due_timezone = $9, due_at = $10, estimate_minutes = $11,
+			recurrence_rule = $12, position = $13, deleted_at = $14
+		WHERE id = $15;`
+	_, err := tx.ExecContext(
+		ctx, query, restoredAt, snapshot.CategoryID, snapshot.Title, snapshot.Description, snapshot.Status,
+		snapshot.Priority, snapshot.DueDate, snapshot.DueTime, snapshot.DueTimezone, snapshot.DueAt,
+		snapshot.EstimateMinutes, snapshot.RecurrenceRule, snapshot.Position, snapshot.DeletedAt, snapshot.After.ID,
+	)
+	if err != nil {
+		// another task took the position since the operation
+		if isTaskPositionConflict(err) {
+			return customErrors.ErrUndoConflict
+		}
+		if customErrors.IsDuplicateDBError(err) {
+			return customErrors.ErrTaskAlreadyExists
+		}
+		return err
+	}
+
+	if snapshot.TagIDs == nil {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM task_tags WHERE task_id = $1;", snapshot.After.ID); err != nil {
+		return err
+	}
+	// tags deleted since the operation are skipped
+	query = `
+		INSERT INTO task_tags (task_id, tag_id)
+		SELECT $1, id FROM tags WHERE id = ANY($2::uuid[]) AND user_id = $3;`
+	_, err = tx.ExecContext(ctx, query, snapshot.After.ID, pq.Array(snapshot.TagIDs), userID)
+
+	return err
+}
+
+// lockTaskVersion locks the task for the rest of the transaction,
+// ErrUndoConflict is returned when the task has changed since the given version.
+func lockTaskVersion(ctx context.Context, tx *sqlx.Tx, userID string, version domain.TaskVersion) error {
+	var id string
+
+	query := `
+		SELECT id FROM tasks
+		WHERE id = $1 AND user_id = $2 AND updated_at = $3 AND deleted_at IS NOT DISTINCT FROM $4
+		FOR UPDATE;`
+	err := tx.GetContext(ctx, &id, query, version.ID, userID, version.UpdatedAt, version.DeletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return customErrors.ErrUndoConflict
+	}
+
+	return err
+}
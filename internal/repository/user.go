@@ -19,7 +19,7 @@ func NewUserRepo(db *sqlx.DB) *UserRepo {
 
 func (r *UserRepo) Create(ctx context.Context, user domain.User) error {
 	query := "INSERT INTO users (name, email, password, created_at) values ($1, $2, $3, $4);"
-	_, err := r.db.ExecContext(ctx, query, user.Name, user.Email, user.Password, user.CreatedAt)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, user.Name, user.Email, user.Password, user.CreatedAt)
 	if err != nil {
 		if customErrors.IsDuplicateDBError(err) {
 			return customErrors.ErrUserAlreadyExists
@@ -32,7 +32,7 @@ func (r *UserRepo) Create(ctx context.Context, user domain.User) error {
 func (r *UserRepo) GetByID(ctx context.Context, id string) (domain.User, error) {
 	var user domain.User
 	query := "SELECT id, created_at, name, email FROM users WHERE id = $1;"
-	if err := r.db.GetContext(ctx, &user, query, id); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &user, query, id); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.User{}, customErrors.ErrUserNotFound
 		}
@@ -46,7 +46,7 @@ func (r *UserRepo) GetByID(ctx context.Context, id string) (domain.User, error)
 func (r *UserRepo) GetByEmail(ctx context.Context, email string) (domain.User, error) {
 	var user domain.User
 	query := "SELECT id, created_at, name, email, password FROM users WHERE email = $1;"
-	if err := r.db.GetContext(ctx, &user, query, email); err != nil {
+	if err := conn(ctx, r.db).GetContext(ctx, &user, query, email); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.User{}, customErrors.ErrUserNotFound
 		}
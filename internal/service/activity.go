@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"math"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type ActivityService struct {
+	repo     repository.ActivityRepository
+	taskRepo repository.TaskRepository
+}
+
+func NewActivityService(repo repository.ActivityRepository, taskRepo repository.TaskRepository) *ActivityService {
+	return &ActivityService{repo: repo, taskRepo: taskRepo}
+}
+
+func (s *ActivityService) GetTaskHistory(ctx context.Context, taskID, userID string) ([]domain.Activity, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListByTaskID(ctx, taskID)
+}
+
+func (s *ActivityService) GetFeed(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) (ActivityListResult, error) {
+	if query.CursorMode() {
+		return s.getCursorFeed(ctx, userID, query)
+	}
+
+	activities, count, err := s.repo.GetListByUserID(ctx, userID, query)
+	if err != nil {
+		return ActivityListResult{}, err
+	}
+
+	return ActivityListResult{
+		Items:      activities,
+		TotalItems: count,
+		TotalPages: int(math.Ceil(float64(count) / float64(query.Limit))),
+	}, nil
+}
+
+func (s *ActivityService) getCursorFeed(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) (ActivityListResult, error) {
+	var after *domain.Cursor
+	if *query.Cursor != "" {
+		cursor, err := domain.DecodeCursor(*query.Cursor)
+		if err != nil {
+			return ActivityListResult{}, customErrors.ErrInvalidCursor
+		}
+		if !validActivityCursor(cursor) {
+			return ActivityListResult{}, customErrors.ErrInvalidCursor
+		}
+		after = &cursor
+	}
+
+	activities, next, err := s.repo.GetCursorListByUserID(ctx, userID, query, after)
+	if err != nil {
+		return ActivityListResult{}, err
+	}
+
+	result := ActivityListResult{Items: activities}
+	if next != nil {
+		nextCursor := next.Encode()
+		result.NextCursor = &nextCursor
+	}
+
+	return result, nil
+}
+
+// validActivityCursor checks that the cursor holds the creation time of an activity.
+func validActivityCursor(cursor domain.Cursor) bool {
+	if cursor.Sort != domain.ActivityFeedSort || len(cursor.Values) != 1 || cursor.Values[0] == nil {
+		return false
+	}
+	if _, err := time.Parse(time.RFC3339Nano, *cursor.Values[0]); err != nil {
+		return false
+	}
+	return uuid.Validate(cursor.ID) == nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+	"todo_list_go/pkg/storage"
+)
+
+// sniffLength is the number of bytes the content type of uploaded files is detected by.
+const sniffLength = 512
+
+type AttachmentService struct {
+	repo     repository.AttachmentRepository
+	taskRepo repository.TaskRepository
+	storage  storage.FileStorage
+	limits   AttachmentLimits
+}
+
+func NewAttachmentService(
+	repo repository.AttachmentRepository, taskRepo repository.TaskRepository, fileStorage storage.FileStorage,
+	limits AttachmentLimits,
+) *AttachmentService {
+	return &AttachmentService{repo: repo, taskRepo: taskRepo, storage: fileStorage, limits: limits}
+}
+
+func (s *AttachmentService) MaxFileSize() int64 {
+	return s.limits.MaxFileSize
+}
+
+// Upload detects the content type of the file by its contents, the declared one isn't trusted.
+// Concurrent uploads of a user may exceed the quota by the size of a file.
+func (s *AttachmentService) Upload(ctx context.Context, inp UploadAttachmentInput) (domain.Attachment, error) {
+	_, err := s.taskRepo.GetByID(ctx, inp.TaskID, inp.UserID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	if inp.Size > s.limits.MaxFileSize {
+		return domain.Attachment{}, customErrors.ErrAttachmentTooLarge
+	}
+	used, err := s.repo.GetTotalSizeByUserID(ctx, inp.UserID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+	if used+inp.Size > s.limits.UserQuota {
+		return domain.Attachment{}, customErrors.ErrStorageQuotaExceeded
+	}
+
+	content := bufio.NewReaderSize(inp.Content, sniffLength)
+	head, err := content.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return domain.Attachment{}, err
+	}
+	contentType := http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !slices.Contains(s.limits.ContentTypes, mediaType) {
+		return domain.Attachment{}, customErrors.ErrAttachmentContentType
+	}
+
+	attachment := domain.Attachment{
+		CreatedAt:   time.Now(),
+		TaskID:      inp.TaskID,
+		UserID:      inp.UserID,
+		FileName:    inp.FileName,
+		ContentType: contentType,
+		Size:        inp.Size,
+		StorageKey:  inp.UserID + "/" + uuid.NewString(),
+	}
+	if err := s.storage.Put(ctx, attachment.StorageKey, content, attachment.Size, contentType); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	createdAttachment, err := s.repo.Create(ctx, attachment)
+	if err != nil {
+		// the stored file would be left without metadata
+		_ = s.storage.Delete(ctx, attachment.StorageKey)
+		return domain.Attachment{}, err
+	}
+
+	return createdAttachment, nil
+}
+
+func (s *AttachmentService) Download(
+	ctx context.Context, attachmentID, taskID, userID string,
+) (domain.Attachment, io.ReadCloser, error) {
+	attachment, err := s.taskAttachment(ctx, attachmentID, taskID, userID)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	content, err := s.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return domain.Attachment{}, nil, customErrors.ErrAttachmentNotFound
+		}
+		return domain.Attachment{}, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+func (s *AttachmentService) Delete(ctx context.Context, attachmentID, taskID, userID string) error {
+	attachment, err := s.taskAttachment(ctx, attachmentID, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, attachmentID); err != nil {
+		return err
+	}
+
+	err = s.storage.Delete(ctx, attachment.StorageKey)
+	if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *AttachmentService) GetList(ctx context.Context, taskID, userID string) ([]domain.Attachment, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repo.GetListByTaskIDs(ctx, []string{taskID})
+	if err != nil {
+		return nil, err
+	}
+	if attachments[taskID] == nil {
+		return make([]domain.Attachment, 0), nil
+	}
+
+	return attachments[taskID], nil
+}
+
+func (s *AttachmentService) taskAttachment(
+	ctx context.Context, attachmentID, taskID, userID string,
+) (domain.Attachment, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	return s.repo.GetByID(ctx, attachmentID, taskID)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"math"
 	"time"
 	"todo_list_go/internal/domain"
 	"todo_list_go/internal/repository"
@@ -57,9 +58,37 @@ func (s *CategoryService) Delete(ctx context.Context, CategoryID, UserID string)
 		return err
 	}
 
-	return s.repo.Delete(ctx, CategoryID)
+	return s.repo.Delete(ctx, CategoryID, time.Now())
+}
+
+func (s *CategoryService) Restore(ctx context.Context, categoryID, userID string) (domain.Category, error) {
+	_, err := s.repo.GetDeletedByID(ctx, categoryID, userID)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	if err := s.repo.Restore(ctx, categoryID); err != nil {
+		return domain.Category{}, err
+	}
+
+	return s.repo.GetByID(ctx, categoryID, userID)
 }
 
 func (s *CategoryService) GetList(ctx context.Context, userID string) ([]domain.Category, error) {
 	return s.repo.GetListByUserID(ctx, userID)
 }
+
+func (s *CategoryService) GetDeletedList(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) (CategoryListResult, error) {
+	categories, count, err := s.repo.GetDeletedListByUserID(ctx, userID, query)
+	if err != nil {
+		return CategoryListResult{}, err
+	}
+
+	return CategoryListResult{
+		Items:      categories,
+		TotalItems: count,
+		TotalPages: int(math.Ceil(float64(count) / float64(query.Limit))),
+	}, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type ChecklistItemService struct {
+	transactor   repository.Transactor
+	repo         repository.ChecklistItemRepository
+	taskRepo     repository.TaskRepository
+	tasksService Task
+}
+
+func NewChecklistItemService(
+	transactor repository.Transactor, repo repository.ChecklistItemRepository, taskRepo repository.TaskRepository,
+	tasksService Task,
+) *ChecklistItemService {
+	return &ChecklistItemService{transactor: transactor, repo: repo, taskRepo: taskRepo, tasksService: tasksService}
+}
+
+func (s *ChecklistItemService) Create(ctx context.Context, inp CreateChecklistItemInput) (domain.ChecklistItem, error) {
+	_, err := s.taskRepo.GetByID(ctx, inp.TaskID, inp.UserID)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	item := domain.ChecklistItem{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		TaskID:    inp.TaskID,
+		Title:     inp.Title,
+		Completed: inp.Completed,
+	}
+
+	return s.repo.Create(ctx, item)
+}
+
+func (s *ChecklistItemService) Update(ctx context.Context, inp UpdateChecklistItemInput) (domain.ChecklistItem, error) {
+	// the task is completed in the transaction of the item, so the item isn't left done with the task open,
+	// and the task row lock makes concurrent updates of the last items see each other
+	var updatedItem domain.ChecklistItem
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := s.taskRepo.GetByIDForUpdate(ctx, inp.TaskID, inp.UserID)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.repo.GetByID(ctx, inp.ID, inp.TaskID)
+		if err != nil {
+			return err
+		}
+
+		if inp.Title == nil && inp.Completed == nil {
+			return customErrors.ErrNoUpdateFields
+		}
+
+		updatedItem, err = s.repo.Update(ctx, repository.UpdateChecklistItemInput{
+			ID:        inp.ID,
+			UpdatedAt: time.Now(),
+			Title:     inp.Title,
+			Completed: inp.Completed,
+		})
+		if err != nil {
+			return err
+		}
+
+		// a blocked task can't be done, so it stays as it is
+		if inp.CompleteTask && updatedItem.Completed && !task.Completed && task.Status.CanTransitionTo(domain.TaskStatusDone) {
+			return s.completeTaskIfChecklistDone(ctx, inp.TaskID, inp.UserID)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	return updatedItem, nil
+}
+
+func (s *ChecklistItemService) Delete(ctx context.Context, itemID, taskID, userID string) error {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetByID(ctx, itemID, taskID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, itemID)
+}
+
+func (s *ChecklistItemService) GetList(ctx context.Context, taskID, userID string) ([]domain.ChecklistItem, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListByTaskID(ctx, taskID)
+}
+
+// Reorder places checklist items in the order of itemIDs, which must list every item of the task.
+func (s *ChecklistItemService) Reorder(
+	ctx context.Context, taskID, userID string, itemIDs []string,
+) ([]domain.ChecklistItem, error) {
+	items, err := s.GetList(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) != len(itemIDs) {
+		return nil, customErrors.ErrInvalidChecklistOrder
+	}
+	taskItemIDs := make(map[string]bool, len(items))
+	for _, item := range items {
+		taskItemIDs[item.ID] = true
+	}
+	for _, itemID := range itemIDs {
+		if !taskItemIDs[itemID] {
+			return nil, customErrors.ErrInvalidChecklistOrder
+		}
+		delete(taskItemIDs, itemID) // catches duplicates
+	}
+
+	if err := s.repo.Reorder(ctx, taskID, itemIDs); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListByTaskID(ctx, taskID)
+}
+
+func (s *ChecklistItemService) completeTaskIfChecklistDone(ctx context.Context, taskID, userID string) error {
+	items, err := s.repo.GetListByTaskID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if !item.Completed {
+			return nil
+		}
+	}
+
+	completed := true
+	_, _, err = s.tasksService.Update(ctx, UpdateTaskInput{ID: taskID, UserID: userID, Completed: &completed})
+	if errors.Is(err, customErrors.ErrTaskBlocked) {
+		// the task stays open until its blockers are done
+		return nil
+	}
+
+	return err
+}
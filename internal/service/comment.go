@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type CommentService struct {
+	repo     repository.CommentRepository
+	taskRepo repository.TaskRepository
+}
+
+func NewCommentService(repo repository.CommentRepository, taskRepo repository.TaskRepository) *CommentService {
+	return &CommentService{repo: repo, taskRepo: taskRepo}
+}
+
+func (s *CommentService) Create(ctx context.Context, inp CreateCommentInput) (domain.Comment, error) {
+	_, err := s.taskRepo.GetByID(ctx, inp.TaskID, inp.UserID)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment := domain.Comment{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		TaskID:    inp.TaskID,
+		UserID:    inp.UserID,
+		Body:      inp.Body,
+	}
+
+	return s.repo.Create(ctx, comment)
+}
+
+func (s *CommentService) Update(ctx context.Context, inp UpdateCommentInput) (domain.Comment, error) {
+	if _, err := s.authoredComment(ctx, inp.ID, inp.TaskID, inp.UserID); err != nil {
+		return domain.Comment{}, err
+	}
+
+	return s.repo.Update(ctx, repository.UpdateCommentInput{
+		ID:        inp.ID,
+		UpdatedAt: time.Now(),
+		Body:      inp.Body,
+	})
+}
+
+func (s *CommentService) Delete(ctx context.Context, commentID, taskID, userID string) error {
+	if _, err := s.authoredComment(ctx, commentID, taskID, userID); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, commentID)
+}
+
+func (s *CommentService) GetList(ctx context.Context, taskID, userID string) ([]domain.Comment, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListByTaskID(ctx, taskID)
+}
+
+// authoredComment returns the comment of the task accessible to the user,
+// the comment must have been written by the user.
+func (s *CommentService) authoredComment(ctx context.Context, commentID, taskID, userID string) (domain.Comment, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment, err := s.repo.GetByID(ctx, commentID, taskID)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	if comment.UserID != userID {
+		return domain.Comment{}, customErrors.ErrNotCommentAuthor
+	}
+
+	return comment, nil
+}
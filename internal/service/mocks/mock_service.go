@@ -11,7 +11,9 @@ package mock_service
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 	domain "todo_list_go/internal/domain"
 	service "todo_list_go/internal/service"
 
@@ -110,6 +112,67 @@ func (m *MockTask) EXPECT() *MockTaskMockRecorder {
 	return m.recorder
 }
 
+// AddDependency mocks base method.
+func (m *MockTask) AddDependency(ctx context.Context, taskID, blockerID, userID string) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", ctx, taskID, blockerID, userID)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockTaskMockRecorder) AddDependency(ctx, taskID, blockerID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockTask)(nil).AddDependency), ctx, taskID, blockerID, userID)
+}
+
+// Archive mocks base method.
+func (m *MockTask) Archive(ctx context.Context, taskID, userID string) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, taskID, userID)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockTaskMockRecorder) Archive(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockTask)(nil).Archive), ctx, taskID, userID)
+}
+
+// ArchiveCompleted mocks base method.
+func (m *MockTask) ArchiveCompleted(ctx context.Context, categoryID, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCompleted", ctx, categoryID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveCompleted indicates an expected call of ArchiveCompleted.
+func (mr *MockTaskMockRecorder) ArchiveCompleted(ctx, categoryID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCompleted", reflect.TypeOf((*MockTask)(nil).ArchiveCompleted), ctx, categoryID, userID)
+}
+
+// Bulk mocks base method.
+func (m *MockTask) Bulk(ctx context.Context, inp service.BulkTaskInput) ([]service.BulkTaskItemResult, service.UndoToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", ctx, inp)
+	ret0, _ := ret[0].([]service.BulkTaskItemResult)
+	ret1, _ := ret[1].(service.UndoToken)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockTaskMockRecorder) Bulk(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockTask)(nil).Bulk), ctx, inp)
+}
+
 // Create mocks base method.
 func (m *MockTask) Create(ctx context.Context, inp service.CreateTaskInput) (service.TaskOutput, error) {
 	m.ctrl.T.Helper()
@@ -125,12 +188,28 @@ func (mr *MockTaskMockRecorder) Create(ctx, inp any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTask)(nil).Create), ctx, inp)
 }
 
+// CreateList mocks base method.
+func (m *MockTask) CreateList(ctx context.Context, inps []service.CreateTaskInput) ([]service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", ctx, inps)
+	ret0, _ := ret[0].([]service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockTaskMockRecorder) CreateList(ctx, inps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockTask)(nil).CreateList), ctx, inps)
+}
+
 // Delete mocks base method.
-func (m *MockTask) Delete(ctx context.Context, taskID, userID string) error {
+func (m *MockTask) Delete(ctx context.Context, taskID, userID string) (service.UndoToken, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, taskID, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(service.UndoToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
@@ -154,6 +233,21 @@ func (mr *MockTaskMockRecorder) GetByID(ctx, taskID, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTask)(nil).GetByID), ctx, taskID, userID)
 }
 
+// GetDeletedList mocks base method.
+func (m *MockTask) GetDeletedList(ctx context.Context, userID string, query domain.PaginationQuery) (service.TaskListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedList", ctx, userID, query)
+	ret0, _ := ret[0].(service.TaskListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedList indicates an expected call of GetDeletedList.
+func (mr *MockTaskMockRecorder) GetDeletedList(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedList", reflect.TypeOf((*MockTask)(nil).GetDeletedList), ctx, userID, query)
+}
+
 // GetList mocks base method.
 func (m *MockTask) GetList(ctx context.Context, userID string, query domain.GetTasksQuery) (service.TaskListResult, error) {
 	m.ctrl.T.Helper()
@@ -169,15 +263,150 @@ func (mr *MockTaskMockRecorder) GetList(ctx, userID, query any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockTask)(nil).GetList), ctx, userID, query)
 }
 
-// Update mocks base method.
-func (m *MockTask) Update(ctx context.Context, inp service.UpdateTaskInput) (service.TaskOutput, error) {
+// GetOccurrences mocks base method.
+func (m *MockTask) GetOccurrences(ctx context.Context, taskID, userID string, count int) ([]domain.TaskDue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret := m.ctrl.Call(m, "GetOccurrences", ctx, taskID, userID, count)
+	ret0, _ := ret[0].([]domain.TaskDue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOccurrences indicates an expected call of GetOccurrences.
+func (mr *MockTaskMockRecorder) GetOccurrences(ctx, taskID, userID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccurrences", reflect.TypeOf((*MockTask)(nil).GetOccurrences), ctx, taskID, userID, count)
+}
+
+// Move mocks base method.
+func (m *MockTask) Move(ctx context.Context, inp service.MoveTaskInput) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, inp)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockTaskMockRecorder) Move(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockTask)(nil).Move), ctx, inp)
+}
+
+// QuickAdd mocks base method.
+func (m *MockTask) QuickAdd(ctx context.Context, inp service.QuickAddTaskInput) (service.QuickAddTaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuickAdd", ctx, inp)
+	ret0, _ := ret[0].(service.QuickAddTaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuickAdd indicates an expected call of QuickAdd.
+func (mr *MockTaskMockRecorder) QuickAdd(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuickAdd", reflect.TypeOf((*MockTask)(nil).QuickAdd), ctx, inp)
+}
+
+// RemoveDependency mocks base method.
+func (m *MockTask) RemoveDependency(ctx context.Context, taskID, blockerID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", ctx, taskID, blockerID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockTaskMockRecorder) RemoveDependency(ctx, taskID, blockerID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockTask)(nil).RemoveDependency), ctx, taskID, blockerID, userID)
+}
+
+// Restore mocks base method.
+func (m *MockTask) Restore(ctx context.Context, taskID, userID string) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, taskID, userID)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskMockRecorder) Restore(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTask)(nil).Restore), ctx, taskID, userID)
+}
+
+// SetRecurrence mocks base method.
+func (m *MockTask) SetRecurrence(ctx context.Context, taskID, userID string, recurrence domain.Recurrence) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRecurrence", ctx, taskID, userID, recurrence)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRecurrence indicates an expected call of SetRecurrence.
+func (mr *MockTaskMockRecorder) SetRecurrence(ctx, taskID, userID, recurrence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRecurrence", reflect.TypeOf((*MockTask)(nil).SetRecurrence), ctx, taskID, userID, recurrence)
+}
+
+// StopRecurrence mocks base method.
+func (m *MockTask) StopRecurrence(ctx context.Context, taskID, userID string) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRecurrence", ctx, taskID, userID)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopRecurrence indicates an expected call of StopRecurrence.
+func (mr *MockTaskMockRecorder) StopRecurrence(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRecurrence", reflect.TypeOf((*MockTask)(nil).StopRecurrence), ctx, taskID, userID)
+}
+
+// Unarchive mocks base method.
+func (m *MockTask) Unarchive(ctx context.Context, taskID, userID string) (service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", ctx, taskID, userID)
 	ret0, _ := ret[0].(service.TaskOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockTaskMockRecorder) Unarchive(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockTask)(nil).Unarchive), ctx, taskID, userID)
+}
+
+// Undo mocks base method.
+func (m *MockTask) Undo(ctx context.Context, token, userID string) ([]service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undo", ctx, token, userID)
+	ret0, _ := ret[0].([]service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Undo indicates an expected call of Undo.
+func (mr *MockTaskMockRecorder) Undo(ctx, token, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undo", reflect.TypeOf((*MockTask)(nil).Undo), ctx, token, userID)
+}
+
+// Update mocks base method.
+func (m *MockTask) Update(ctx context.Context, inp service.UpdateTaskInput) (service.TaskOutput, service.UndoToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(service.TaskOutput)
+	ret1, _ := ret[1].(service.UndoToken)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockTaskMockRecorder) Update(ctx, inp any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -237,6 +466,21 @@ func (mr *MockCategoryMockRecorder) Delete(ctx, categoryID, userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategory)(nil).Delete), ctx, categoryID, userID)
 }
 
+// GetDeletedList mocks base method.
+func (m *MockCategory) GetDeletedList(ctx context.Context, userID string, query domain.PaginationQuery) (service.CategoryListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedList", ctx, userID, query)
+	ret0, _ := ret[0].(service.CategoryListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedList indicates an expected call of GetDeletedList.
+func (mr *MockCategoryMockRecorder) GetDeletedList(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedList", reflect.TypeOf((*MockCategory)(nil).GetDeletedList), ctx, userID, query)
+}
+
 // GetList mocks base method.
 func (m *MockCategory) GetList(ctx context.Context, userID string) ([]domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +496,21 @@ func (mr *MockCategoryMockRecorder) GetList(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockCategory)(nil).GetList), ctx, userID)
 }
 
+// Restore mocks base method.
+func (m *MockCategory) Restore(ctx context.Context, categoryID, userID string) (domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, categoryID, userID)
+	ret0, _ := ret[0].(domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCategoryMockRecorder) Restore(ctx, categoryID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCategory)(nil).Restore), ctx, categoryID, userID)
+}
+
 // Update mocks base method.
 func (m *MockCategory) Update(ctx context.Context, inp service.UpdateCategoryInput) (domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -266,3 +525,812 @@ func (mr *MockCategoryMockRecorder) Update(ctx, inp any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategory)(nil).Update), ctx, inp)
 }
+
+// MockTrash is a mock of Trash interface.
+type MockTrash struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashMockRecorder is the mock recorder for MockTrash.
+type MockTrashMockRecorder struct {
+	mock *MockTrash
+}
+
+// NewMockTrash creates a new mock instance.
+func NewMockTrash(ctrl *gomock.Controller) *MockTrash {
+	mock := &MockTrash{ctrl: ctrl}
+	mock.recorder = &MockTrashMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrash) EXPECT() *MockTrashMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockTrash) Purge(ctx context.Context, retention time.Duration) (service.TrashPurgeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, retention)
+	ret0, _ := ret[0].(service.TrashPurgeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashMockRecorder) Purge(ctx, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrash)(nil).Purge), ctx, retention)
+}
+
+// MockTag is a mock of Tag interface.
+type MockTag struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagMockRecorder
+	isgomock struct{}
+}
+
+// MockTagMockRecorder is the mock recorder for MockTag.
+type MockTagMockRecorder struct {
+	mock *MockTag
+}
+
+// NewMockTag creates a new mock instance.
+func NewMockTag(ctrl *gomock.Controller) *MockTag {
+	mock := &MockTag{ctrl: ctrl}
+	mock.recorder = &MockTagMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTag) EXPECT() *MockTagMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTag) Create(ctx context.Context, inp service.CreateTagInput) (domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTag)(nil).Create), ctx, inp)
+}
+
+// Delete mocks base method.
+func (m *MockTag) Delete(ctx context.Context, tagID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tagID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagMockRecorder) Delete(ctx, tagID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTag)(nil).Delete), ctx, tagID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockTag) GetList(ctx context.Context, userID string) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, userID)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockTagMockRecorder) GetList(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockTag)(nil).GetList), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockTag) Update(ctx context.Context, inp service.UpdateTagInput) (domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTagMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTag)(nil).Update), ctx, inp)
+}
+
+// MockChecklistItem is a mock of ChecklistItem interface.
+type MockChecklistItem struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistItemMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistItemMockRecorder is the mock recorder for MockChecklistItem.
+type MockChecklistItemMockRecorder struct {
+	mock *MockChecklistItem
+}
+
+// NewMockChecklistItem creates a new mock instance.
+func NewMockChecklistItem(ctrl *gomock.Controller) *MockChecklistItem {
+	mock := &MockChecklistItem{ctrl: ctrl}
+	mock.recorder = &MockChecklistItemMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistItem) EXPECT() *MockChecklistItemMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChecklistItem) Create(ctx context.Context, inp service.CreateChecklistItemInput) (domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChecklistItemMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChecklistItem)(nil).Create), ctx, inp)
+}
+
+// Delete mocks base method.
+func (m *MockChecklistItem) Delete(ctx context.Context, itemID, taskID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, itemID, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChecklistItemMockRecorder) Delete(ctx, itemID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChecklistItem)(nil).Delete), ctx, itemID, taskID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockChecklistItem) GetList(ctx context.Context, taskID, userID string) ([]domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, taskID, userID)
+	ret0, _ := ret[0].([]domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockChecklistItemMockRecorder) GetList(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockChecklistItem)(nil).GetList), ctx, taskID, userID)
+}
+
+// Reorder mocks base method.
+func (m *MockChecklistItem) Reorder(ctx context.Context, taskID, userID string, itemIDs []string) ([]domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, taskID, userID, itemIDs)
+	ret0, _ := ret[0].([]domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockChecklistItemMockRecorder) Reorder(ctx, taskID, userID, itemIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockChecklistItem)(nil).Reorder), ctx, taskID, userID, itemIDs)
+}
+
+// Update mocks base method.
+func (m *MockChecklistItem) Update(ctx context.Context, inp service.UpdateChecklistItemInput) (domain.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistItemMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistItem)(nil).Update), ctx, inp)
+}
+
+// MockTimeEntry is a mock of TimeEntry interface.
+type MockTimeEntry struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeEntryMockRecorder is the mock recorder for MockTimeEntry.
+type MockTimeEntryMockRecorder struct {
+	mock *MockTimeEntry
+}
+
+// NewMockTimeEntry creates a new mock instance.
+func NewMockTimeEntry(ctrl *gomock.Controller) *MockTimeEntry {
+	mock := &MockTimeEntry{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntry) EXPECT() *MockTimeEntryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTimeEntry) Create(ctx context.Context, inp service.CreateTimeEntryInput) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTimeEntryMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTimeEntry)(nil).Create), ctx, inp)
+}
+
+// Delete mocks base method.
+func (m *MockTimeEntry) Delete(ctx context.Context, entryID, taskID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, entryID, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTimeEntryMockRecorder) Delete(ctx, entryID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTimeEntry)(nil).Delete), ctx, entryID, taskID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockTimeEntry) GetList(ctx context.Context, taskID, userID string) ([]domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, taskID, userID)
+	ret0, _ := ret[0].([]domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockTimeEntryMockRecorder) GetList(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockTimeEntry)(nil).GetList), ctx, taskID, userID)
+}
+
+// GetReport mocks base method.
+func (m *MockTimeEntry) GetReport(ctx context.Context, inp service.TimeReportInput) (domain.TimeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, inp)
+	ret0, _ := ret[0].(domain.TimeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockTimeEntryMockRecorder) GetReport(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockTimeEntry)(nil).GetReport), ctx, inp)
+}
+
+// GetRunningTimer mocks base method.
+func (m *MockTimeEntry) GetRunningTimer(ctx context.Context, userID string) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningTimer", ctx, userID)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningTimer indicates an expected call of GetRunningTimer.
+func (mr *MockTimeEntryMockRecorder) GetRunningTimer(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningTimer", reflect.TypeOf((*MockTimeEntry)(nil).GetRunningTimer), ctx, userID)
+}
+
+// StartTimer mocks base method.
+func (m *MockTimeEntry) StartTimer(ctx context.Context, taskID, userID string) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", ctx, taskID, userID)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockTimeEntryMockRecorder) StartTimer(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockTimeEntry)(nil).StartTimer), ctx, taskID, userID)
+}
+
+// StopTimer mocks base method.
+func (m *MockTimeEntry) StopTimer(ctx context.Context, taskID, userID string) (domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimer", ctx, taskID, userID)
+	ret0, _ := ret[0].(domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTimer indicates an expected call of StopTimer.
+func (mr *MockTimeEntryMockRecorder) StopTimer(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimer", reflect.TypeOf((*MockTimeEntry)(nil).StopTimer), ctx, taskID, userID)
+}
+
+// MockComment is a mock of Comment interface.
+type MockComment struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentMockRecorder is the mock recorder for MockComment.
+type MockCommentMockRecorder struct {
+	mock *MockComment
+}
+
+// NewMockComment creates a new mock instance.
+func NewMockComment(ctrl *gomock.Controller) *MockComment {
+	mock := &MockComment{ctrl: ctrl}
+	mock.recorder = &MockCommentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComment) EXPECT() *MockCommentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockComment) Create(ctx context.Context, inp service.CreateCommentInput) (domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockComment)(nil).Create), ctx, inp)
+}
+
+// Delete mocks base method.
+func (m *MockComment) Delete(ctx context.Context, commentID, taskID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, commentID, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentMockRecorder) Delete(ctx, commentID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockComment)(nil).Delete), ctx, commentID, taskID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockComment) GetList(ctx context.Context, taskID, userID string) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, taskID, userID)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockCommentMockRecorder) GetList(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockComment)(nil).GetList), ctx, taskID, userID)
+}
+
+// Update mocks base method.
+func (m *MockComment) Update(ctx context.Context, inp service.UpdateCommentInput) (domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockComment)(nil).Update), ctx, inp)
+}
+
+// MockAttachment is a mock of Attachment interface.
+type MockAttachment struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentMockRecorder is the mock recorder for MockAttachment.
+type MockAttachmentMockRecorder struct {
+	mock *MockAttachment
+}
+
+// NewMockAttachment creates a new mock instance.
+func NewMockAttachment(ctrl *gomock.Controller) *MockAttachment {
+	mock := &MockAttachment{ctrl: ctrl}
+	mock.recorder = &MockAttachmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachment) EXPECT() *MockAttachmentMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAttachment) Delete(ctx context.Context, attachmentID, taskID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, attachmentID, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentMockRecorder) Delete(ctx, attachmentID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachment)(nil).Delete), ctx, attachmentID, taskID, userID)
+}
+
+// Download mocks base method.
+func (m *MockAttachment) Download(ctx context.Context, attachmentID, taskID, userID string) (domain.Attachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, attachmentID, taskID, userID)
+	ret0, _ := ret[0].(domain.Attachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockAttachmentMockRecorder) Download(ctx, attachmentID, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAttachment)(nil).Download), ctx, attachmentID, taskID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockAttachment) GetList(ctx context.Context, taskID, userID string) ([]domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, taskID, userID)
+	ret0, _ := ret[0].([]domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockAttachmentMockRecorder) GetList(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockAttachment)(nil).GetList), ctx, taskID, userID)
+}
+
+// MaxFileSize mocks base method.
+func (m *MockAttachment) MaxFileSize() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxFileSize")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// MaxFileSize indicates an expected call of MaxFileSize.
+func (mr *MockAttachmentMockRecorder) MaxFileSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxFileSize", reflect.TypeOf((*MockAttachment)(nil).MaxFileSize))
+}
+
+// Upload mocks base method.
+func (m *MockAttachment) Upload(ctx context.Context, inp service.UploadAttachmentInput) (domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, inp)
+	ret0, _ := ret[0].(domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentMockRecorder) Upload(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachment)(nil).Upload), ctx, inp)
+}
+
+// MockActivity is a mock of Activity interface.
+type MockActivity struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityMockRecorder is the mock recorder for MockActivity.
+type MockActivityMockRecorder struct {
+	mock *MockActivity
+}
+
+// NewMockActivity creates a new mock instance.
+func NewMockActivity(ctrl *gomock.Controller) *MockActivity {
+	mock := &MockActivity{ctrl: ctrl}
+	mock.recorder = &MockActivityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivity) EXPECT() *MockActivityMockRecorder {
+	return m.recorder
+}
+
+// GetFeed mocks base method.
+func (m *MockActivity) GetFeed(ctx context.Context, userID string, query domain.PaginationQuery) (service.ActivityListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, userID, query)
+	ret0, _ := ret[0].(service.ActivityListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockActivityMockRecorder) GetFeed(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockActivity)(nil).GetFeed), ctx, userID, query)
+}
+
+// GetTaskHistory mocks base method.
+func (m *MockActivity) GetTaskHistory(ctx context.Context, taskID, userID string) ([]domain.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskHistory", ctx, taskID, userID)
+	ret0, _ := ret[0].([]domain.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskHistory indicates an expected call of GetTaskHistory.
+func (mr *MockActivityMockRecorder) GetTaskHistory(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskHistory", reflect.TypeOf((*MockActivity)(nil).GetTaskHistory), ctx, taskID, userID)
+}
+
+// MockTemplate is a mock of Template interface.
+type MockTemplate struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateMockRecorder
+	isgomock struct{}
+}
+
+// MockTemplateMockRecorder is the mock recorder for MockTemplate.
+type MockTemplateMockRecorder struct {
+	mock *MockTemplate
+}
+
+// NewMockTemplate creates a new mock instance.
+func NewMockTemplate(ctrl *gomock.Controller) *MockTemplate {
+	mock := &MockTemplate{ctrl: ctrl}
+	mock.recorder = &MockTemplateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplate) EXPECT() *MockTemplateMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTemplate) Create(ctx context.Context, inp service.CreateTemplateInput) (domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTemplateMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTemplate)(nil).Create), ctx, inp)
+}
+
+// Delete mocks base method.
+func (m *MockTemplate) Delete(ctx context.Context, templateID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, templateID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTemplateMockRecorder) Delete(ctx, templateID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplate)(nil).Delete), ctx, templateID, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockTemplate) GetByID(ctx context.Context, templateID, userID string) (domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, templateID, userID)
+	ret0, _ := ret[0].(domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTemplateMockRecorder) GetByID(ctx, templateID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTemplate)(nil).GetByID), ctx, templateID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockTemplate) GetList(ctx context.Context, userID string) ([]domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, userID)
+	ret0, _ := ret[0].([]domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockTemplateMockRecorder) GetList(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockTemplate)(nil).GetList), ctx, userID)
+}
+
+// Instantiate mocks base method.
+func (m *MockTemplate) Instantiate(ctx context.Context, inp service.InstantiateTemplateInput) ([]service.TaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Instantiate", ctx, inp)
+	ret0, _ := ret[0].([]service.TaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Instantiate indicates an expected call of Instantiate.
+func (mr *MockTemplateMockRecorder) Instantiate(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Instantiate", reflect.TypeOf((*MockTemplate)(nil).Instantiate), ctx, inp)
+}
+
+// Update mocks base method.
+func (m *MockTemplate) Update(ctx context.Context, inp service.UpdateTemplateInput) (domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTemplateMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTemplate)(nil).Update), ctx, inp)
+}
+
+// MockSmartList is a mock of SmartList interface.
+type MockSmartList struct {
+	ctrl     *gomock.Controller
+	recorder *MockSmartListMockRecorder
+	isgomock struct{}
+}
+
+// MockSmartListMockRecorder is the mock recorder for MockSmartList.
+type MockSmartListMockRecorder struct {
+	mock *MockSmartList
+}
+
+// NewMockSmartList creates a new mock instance.
+func NewMockSmartList(ctrl *gomock.Controller) *MockSmartList {
+	mock := &MockSmartList{ctrl: ctrl}
+	mock.recorder = &MockSmartListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSmartList) EXPECT() *MockSmartListMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSmartList) Create(ctx context.Context, inp service.CreateSmartListInput) (domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, inp)
+	ret0, _ := ret[0].(domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSmartListMockRecorder) Create(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSmartList)(nil).Create), ctx, inp)
+}
+
+// Delete mocks base method.
+func (m *MockSmartList) Delete(ctx context.Context, listID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, listID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSmartListMockRecorder) Delete(ctx, listID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSmartList)(nil).Delete), ctx, listID, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockSmartList) GetByID(ctx context.Context, listID, userID string) (domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, listID, userID)
+	ret0, _ := ret[0].(domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSmartListMockRecorder) GetByID(ctx, listID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSmartList)(nil).GetByID), ctx, listID, userID)
+}
+
+// GetList mocks base method.
+func (m *MockSmartList) GetList(ctx context.Context, userID string) ([]domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, userID)
+	ret0, _ := ret[0].([]domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockSmartListMockRecorder) GetList(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockSmartList)(nil).GetList), ctx, userID)
+}
+
+// GetTasks mocks base method.
+func (m *MockSmartList) GetTasks(ctx context.Context, listID, userID string, pagination domain.PaginationQuery) (service.TaskListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", ctx, listID, userID, pagination)
+	ret0, _ := ret[0].(service.TaskListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasks indicates an expected call of GetTasks.
+func (mr *MockSmartListMockRecorder) GetTasks(ctx, listID, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockSmartList)(nil).GetTasks), ctx, listID, userID, pagination)
+}
+
+// Update mocks base method.
+func (m *MockSmartList) Update(ctx context.Context, inp service.UpdateSmartListInput) (domain.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, inp)
+	ret0, _ := ret[0].(domain.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSmartListMockRecorder) Update(ctx, inp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSmartList)(nil).Update), ctx, inp)
+}
@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 	"todo_list_go/internal/domain"
 	"todo_list_go/internal/repository"
 	"todo_list_go/pkg/auth"
 	"todo_list_go/pkg/hash"
+	"todo_list_go/pkg/storage"
 )
 
 //go:generate mockgen -source=service.go -destination=mocks/mock_service.go
@@ -34,39 +36,187 @@ type CreateTaskInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Completed   bool   `json:"completed"`
+	// Status takes precedence over Completed, a completed task without status is done
+	Status      string `json:"status"`
+	Priority    string `json:"priority"`
+	DueDate     string `json:"due_date"`
+	DueTime     string `json:"due_time"`
+	DueTimezone string `json:"due_timezone"`
+	// EstimateMinutes is 0 for tasks without an estimate
+	EstimateMinutes int `json:"estimate_minutes"`
+	// Recurrence makes the task recurring, it requires DueDate
+	Recurrence *domain.Recurrence `json:"recurrence"`
+	TagIDs     []string           `json:"tag_ids"`
+	// ChecklistItems are titles of the checklist items created together with the task by CreateList
+	ChecklistItems []string `json:"checklist_items"`
 }
 
+// QuickAddTaskInput Text is a single line parsed with domain.ParseQuickAdd, CategoryID is used when it has no #category.
+// Relative dates are taken in Timezone, UTC by default, it becomes the due timezone of tasks due at a time.
+type QuickAddTaskInput struct {
+	UserID     string `json:"user_id"`
+	Text       string `json:"text"`
+	CategoryID string `json:"category_id"`
+	Timezone   string `json:"timezone"`
+	DryRun     bool   `json:"dry_run"`
+}
+
+// QuickAddTaskResult Input is the task parsed from the text, Task is nil in dry-run mode.
+type QuickAddTaskResult struct {
+	Input    CreateTaskInput `json:"input"`
+	Category domain.Category `json:"category"`
+	Tags     []domain.Tag    `json:"tags"`
+	Task     *TaskOutput     `json:"task"`
+}
+
+// UpdateTaskInput due fields set to an empty string clear the stored value,
+// clearing DueDate clears DueTime and DueTimezone as well.
+// Status takes precedence over Completed, uncompleting a done task moves it back to todo.
+// EstimateMinutes set to 0 clears the estimate, non-nil TagIDs replace tags of the task.
 type UpdateTaskInput struct {
-	ID          string  `json:"id"`
-	UserID      string  `json:"user_id"`
-	CategoryID  *string `json:"category_id"`
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	Completed   *bool   `json:"completed"`
+	ID              string   `json:"id"`
+	UserID          string   `json:"user_id"`
+	CategoryID      *string  `json:"category_id"`
+	Title           *string  `json:"title"`
+	Description     *string  `json:"description"`
+	Completed       *bool    `json:"completed"`
+	Status          *string  `json:"status"`
+	Priority        *string  `json:"priority"`
+	DueDate         *string  `json:"due_date"`
+	DueTime         *string  `json:"due_time"`
+	DueTimezone     *string  `json:"due_timezone"`
+	EstimateMinutes *int     `json:"estimate_minutes"`
+	TagIDs          []string `json:"tag_ids"`
 }
 
 type TaskOutput struct {
-	ID          string          `json:"id"`
-	CreatedAt   time.Time       `json:"created_at"`
-	UpdatedAt   time.Time       `json:"updated_at"`
-	Category    domain.Category `json:"category"`
-	Title       string          `json:"title"`
-	Description string          `json:"description"`
-	Completed   bool            `json:"completed"`
+	ID              string              `json:"id"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+	Category        domain.Category     `json:"category"`
+	Title           string              `json:"title"`
+	Description     string              `json:"description"`
+	Completed       bool                `json:"completed"`
+	Status          domain.TaskStatus   `json:"status"`
+	Priority        domain.TaskPriority `json:"priority"`
+	DueDate         *time.Time          `json:"due_date"`
+	DueTime         *string             `json:"due_time"`
+	DueTimezone     *string             `json:"due_timezone"`
+	DueAt           *time.Time          `json:"due_at"`
+	EstimateMinutes *int                `json:"estimate_minutes"`
+	RecurrenceRule  *string             `json:"recurrence_rule"`
+	SeriesID        *string             `json:"series_id"`
+	Position        string              `json:"position"`
+	ArchivedAt      *time.Time          `json:"archived_at"`
+	DeletedAt       *time.Time          `json:"deleted_at"`
+	Tags            []domain.Tag        `json:"tags"`
+	BlockedBy       []string            `json:"blocked_by"`
+	Blocks          []string            `json:"blocks"`
+	Attachments     []domain.Attachment `json:"attachments"`
+	// ChecklistDone and ChecklistTotal count completed and all checklist items of the task
+	ChecklistDone  int `json:"checklist_done"`
+	ChecklistTotal int `json:"checklist_total"`
+	// TrackedMinutes sums time entries of the task including the running timer
+	TrackedMinutes int `json:"tracked_minutes"`
+	CommentsCount  int `json:"comments_count"`
+	// search fields are set only for full-text search results
+	SearchRank           *float64 `json:"search_rank"`
+	TitleHighlight       *string  `json:"title_highlight"`
+	DescriptionHighlight *string  `json:"description_highlight"`
+}
+
+const (
+	BulkTaskComplete   = "complete"
+	BulkTaskUncomplete = "uncomplete"
+	BulkTaskMove       = "move"
+	BulkTaskDelete     = "delete"
+	BulkTaskUpdate     = "update"
+)
+
+// MaxBulkTasks limits the number of tasks changed by one bulk operation.
+const MaxBulkTasks = 100
+
+// BulkTaskInput targets the tasks given by TaskIDs or all tasks matching Filters.
+// CategoryID is used by the move action, the update action sets non-nil Priority and due fields
+// the same way UpdateTaskInput does.
+type BulkTaskInput struct {
+	UserID      string                   `json:"user_id"`
+	Action      string                   `json:"action"`
+	TaskIDs     []string                 `json:"task_ids"`
+	Filters     *domain.TaskFiltersQuery `json:"filters"`
+	CategoryID  string                   `json:"category_id"`
+	Priority    *string                  `json:"priority"`
+	DueDate     *string                  `json:"due_date"`
+	DueTime     *string                  `json:"due_time"`
+	DueTimezone *string                  `json:"due_timezone"`
+}
+
+// BulkTaskItemResult holds the error that prevented the task change, Err is nil for changed tasks.
+type BulkTaskItemResult struct {
+	TaskID string
+	Err    error
 }
 
+// MoveTaskInput places the task after the AfterID task and before the BeforeID task,
+// AfterID is empty when the task is moved to the start of the category and BeforeID when it is moved to the end.
+type MoveTaskInput struct {
+	ID       string `json:"id"`
+	UserID   string `json:"user_id"`
+	AfterID  string `json:"after_id"`
+	BeforeID string `json:"before_id"`
+}
+
+// TaskListResult contains TotalItems and TotalPages in page mode and NextCursor in cursor mode,
+// NextCursor is nil on the last page.
 type TaskListResult struct {
 	Items      []TaskOutput
 	TotalItems int64
 	TotalPages int
+	NextCursor *string
+}
+
+// UndoToken redeems the operation it's returned for until ExpiresAt,
+// Token is empty when the operation changed nothing.
+type UndoToken struct {
+	Token     string
+	ExpiresAt time.Time
 }
 
 type Task interface {
 	Create(ctx context.Context, inp CreateTaskInput) (TaskOutput, error)
-	Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput, error)
-	Delete(ctx context.Context, taskID, userID string) error
+	// QuickAdd parses the text and creates the task, nothing is saved in dry-run mode.
+	QuickAdd(ctx context.Context, inp QuickAddTaskInput) (QuickAddTaskResult, error)
+	// CreateList creates the tasks with their checklists in one transaction, the tasks are returned in the same order.
+	CreateList(ctx context.Context, inps []CreateTaskInput) ([]TaskOutput, error)
+	Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput, UndoToken, error)
+	// Bulk applies the action to all target tasks in one transaction,
+	// tasks that can't be changed are skipped and reported in the results.
+	Bulk(ctx context.Context, inp BulkTaskInput) ([]BulkTaskItemResult, UndoToken, error)
+	// AddDependency makes the task blocked by another task of the user, dependency cycles are rejected.
+	AddDependency(ctx context.Context, taskID, blockerID, userID string) (TaskOutput, error)
+	RemoveDependency(ctx context.Context, taskID, blockerID, userID string) error
+	// Move changes the task position within its category.
+	Move(ctx context.Context, inp MoveTaskInput) (TaskOutput, error)
+	// Delete moves the task to the trash.
+	Delete(ctx context.Context, taskID, userID string) (UndoToken, error)
+	// Undo brings the tasks changed by the operation of the token back to their previous state and returns them,
+	// it fails when any of the tasks has changed since the operation.
+	Undo(ctx context.Context, token, userID string) ([]TaskOutput, error)
+	// Restore brings the task back from the trash, the task category must not be in the trash.
+	Restore(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	Archive(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	Unarchive(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	// ArchiveCompleted archives all completed tasks of the category and returns their number.
+	ArchiveCompleted(ctx context.Context, categoryID, userID string) (int64, error)
 	GetByID(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	// GetDeletedList returns a page of tasks in the trash, cursor pagination isn't supported.
+	GetDeletedList(ctx context.Context, userID string, query domain.PaginationQuery) (TaskListResult, error)
 	GetList(ctx context.Context, userID string, query domain.GetTasksQuery) (TaskListResult, error)
+	// SetRecurrence sets the recurrence of the task series, a task without series starts a new one.
+	SetRecurrence(ctx context.Context, taskID, userID string, recurrence domain.Recurrence) (TaskOutput, error)
+	StopRecurrence(ctx context.Context, taskID, userID string) (TaskOutput, error)
+	// GetOccurrences returns due dates of the next count occurrences of the task series.
+	GetOccurrences(ctx context.Context, taskID, userID string, count int) ([]domain.TaskDue, error)
 }
 
 type CreateCategoryInput struct {
@@ -87,8 +237,230 @@ type UpdateCategoryInput struct {
 type Category interface {
 	Create(ctx context.Context, inp CreateCategoryInput) (domain.Category, error)
 	Update(ctx context.Context, inp UpdateCategoryInput) (domain.Category, error)
+	// Delete moves the category and its tasks to the trash.
 	Delete(ctx context.Context, categoryID, userID string) error
+	// Restore brings the category back from the trash with the tasks deleted together with it.
+	Restore(ctx context.Context, categoryID, userID string) (domain.Category, error)
 	GetList(ctx context.Context, userID string) ([]domain.Category, error)
+	// GetDeletedList returns a page of categories in the trash, cursor pagination isn't supported.
+	GetDeletedList(ctx context.Context, userID string, query domain.PaginationQuery) (CategoryListResult, error)
+}
+
+// CategoryListResult is paginated the same way as TaskListResult, only by page.
+type CategoryListResult struct {
+	Items      []domain.Category
+	TotalItems int64
+	TotalPages int
+}
+
+type TrashPurgeResult struct {
+	Tasks      int64
+	Categories int64
+}
+
+type Trash interface {
+	// Purge permanently removes tasks and categories that have been in the trash longer than retention.
+	Purge(ctx context.Context, retention time.Duration) (TrashPurgeResult, error)
+}
+
+type CreateTagInput struct {
+	UserID string `json:"user_id"`
+	Title  string `json:"title"`
+	Color  string `json:"color"`
+}
+
+type UpdateTagInput struct {
+	ID     string  `json:"id"`
+	UserID string  `json:"user_id"`
+	Title  *string `json:"title"`
+	Color  *string `json:"color"`
+}
+
+type Tag interface {
+	Create(ctx context.Context, inp CreateTagInput) (domain.Tag, error)
+	Update(ctx context.Context, inp UpdateTagInput) (domain.Tag, error)
+	Delete(ctx context.Context, tagID, userID string) error
+	GetList(ctx context.Context, userID string) ([]domain.Tag, error)
+}
+
+type CreateChecklistItemInput struct {
+	TaskID    string `json:"task_id"`
+	UserID    string `json:"user_id"`
+	Title     string `json:"title"`
+	Completed bool   `json:"completed"`
+}
+
+// UpdateChecklistItemInput with CompleteTask set completes the task
+// when the update completes its last open checklist item.
+type UpdateChecklistItemInput struct {
+	ID           string  `json:"id"`
+	TaskID       string  `json:"task_id"`
+	UserID       string  `json:"user_id"`
+	Title        *string `json:"title"`
+	Completed    *bool   `json:"completed"`
+	CompleteTask bool    `json:"complete_task"`
+}
+
+type ChecklistItem interface {
+	Create(ctx context.Context, inp CreateChecklistItemInput) (domain.ChecklistItem, error)
+	Update(ctx context.Context, inp UpdateChecklistItemInput) (domain.ChecklistItem, error)
+	Delete(ctx context.Context, itemID, taskID, userID string) error
+	GetList(ctx context.Context, taskID, userID string) ([]domain.ChecklistItem, error)
+	Reorder(ctx context.Context, taskID, userID string, itemIDs []string) ([]domain.ChecklistItem, error)
+}
+
+// CreateTimeEntryInput is time spent on the task without a timer.
+type CreateTimeEntryInput struct {
+	TaskID    string    `json:"task_id"`
+	UserID    string    `json:"user_id"`
+	StartedAt time.Time `json:"started_at"`
+	Minutes   int       `json:"minutes"`
+}
+
+// TimeReportInput dates are in yyyy-mm-dd format and both included in the report,
+// empty Timezone stands for UTC.
+type TimeReportInput struct {
+	UserID   string `json:"user_id"`
+	DateFrom string `json:"date_from"`
+	DateTo   string `json:"date_to"`
+	Timezone string `json:"timezone"`
+}
+
+type TimeEntry interface {
+	// StartTimer starts a timer on the task, a user can't have more than one running timer.
+	StartTimer(ctx context.Context, taskID, userID string) (domain.TimeEntry, error)
+	StopTimer(ctx context.Context, taskID, userID string) (domain.TimeEntry, error)
+	GetRunningTimer(ctx context.Context, userID string) (domain.TimeEntry, error)
+	Create(ctx context.Context, inp CreateTimeEntryInput) (domain.TimeEntry, error)
+	Delete(ctx context.Context, entryID, taskID, userID string) error
+	GetList(ctx context.Context, taskID, userID string) ([]domain.TimeEntry, error)
+	// GetReport sums tracked time by category and by day.
+	GetReport(ctx context.Context, inp TimeReportInput) (domain.TimeReport, error)
+}
+
+type CreateCommentInput struct {
+	TaskID string `json:"task_id"`
+	UserID string `json:"user_id"`
+	Body   string `json:"body"`
+}
+
+type UpdateCommentInput struct {
+	ID     string `json:"id"`
+	TaskID string `json:"task_id"`
+	UserID string `json:"user_id"`
+	Body   string `json:"body"`
+}
+
+type Comment interface {
+	Create(ctx context.Context, inp CreateCommentInput) (domain.Comment, error)
+	// Update and Delete are allowed to the author of the comment only.
+	Update(ctx context.Context, inp UpdateCommentInput) (domain.Comment, error)
+	Delete(ctx context.Context, commentID, taskID, userID string) error
+	GetList(ctx context.Context, taskID, userID string) ([]domain.Comment, error)
+}
+
+// AttachmentLimits MaxFileSize and UserQuota are in bytes,
+// ContentTypes lists media types allowed for attachments, e.g. "image/png".
+type AttachmentLimits struct {
+	MaxFileSize  int64
+	UserQuota    int64
+	ContentTypes []string
+}
+
+type UploadAttachmentInput struct {
+	TaskID   string
+	UserID   string
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+type Attachment interface {
+	// Upload stores the file and attaches it to the task, the file must fit the attachment limits.
+	Upload(ctx context.Context, inp UploadAttachmentInput) (domain.Attachment, error)
+	// Download returns the attachment with its contents, the caller must close them.
+	Download(ctx context.Context, attachmentID, taskID, userID string) (domain.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, attachmentID, taskID, userID string) error
+	GetList(ctx context.Context, taskID, userID string) ([]domain.Attachment, error)
+	// MaxFileSize returns the limit of attached files in bytes.
+	MaxFileSize() int64
+}
+
+// ActivityListResult is paginated the same way as TaskListResult.
+type ActivityListResult struct {
+	Items      []domain.Activity
+	TotalItems int64
+	TotalPages int
+	NextCursor *string
+}
+
+type Activity interface {
+	// GetTaskHistory returns changes of the task, newest changes go first.
+	GetTaskHistory(ctx context.Context, taskID, userID string) ([]domain.Activity, error)
+	// GetFeed returns changes of all tasks of the user, newest changes go first.
+	GetFeed(ctx context.Context, userID string, query domain.PaginationQuery) (ActivityListResult, error)
+}
+
+type CreateTemplateInput struct {
+	UserID      string                `json:"user_id"`
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	Tasks       []domain.TemplateTask `json:"tasks"`
+}
+
+// UpdateTemplateInput non-nil Tasks replace all tasks of the template.
+type UpdateTemplateInput struct {
+	ID          string                `json:"id"`
+	UserID      string                `json:"user_id"`
+	Title       *string               `json:"title"`
+	Description *string               `json:"description"`
+	Tasks       []domain.TemplateTask `json:"tasks"`
+}
+
+// InstantiateTemplateInput StartDate is a YYYY-MM-DD date the due offsets are counted from, today in UTC by default.
+// Variables give values of the {{variable}} placeholders.
+type InstantiateTemplateInput struct {
+	ID        string            `json:"id"`
+	UserID    string            `json:"user_id"`
+	StartDate string            `json:"start_date"`
+	Variables map[string]string `json:"variables"`
+}
+
+type Template interface {
+	Create(ctx context.Context, inp CreateTemplateInput) (domain.TaskTemplate, error)
+	Update(ctx context.Context, inp UpdateTemplateInput) (domain.TaskTemplate, error)
+	Delete(ctx context.Context, templateID, userID string) error
+	GetByID(ctx context.Context, templateID, userID string) (domain.TaskTemplate, error)
+	GetList(ctx context.Context, userID string) ([]domain.TaskTemplate, error)
+	// Instantiate creates all tasks of the template in one transaction with the variables substituted in titles,
+	// the tasks are returned in the template order. Titles are unique, so ErrTaskAlreadyExists is returned
+	// when an earlier instance of the template without variables still exists.
+	Instantiate(ctx context.Context, inp InstantiateTemplateInput) ([]TaskOutput, error)
+}
+
+type CreateSmartListInput struct {
+	UserID string                `json:"user_id"`
+	Title  string                `json:"title"`
+	Query  domain.SmartListQuery `json:"query"`
+}
+
+// UpdateSmartListInput non-nil Query replaces the whole query of the list.
+type UpdateSmartListInput struct {
+	ID     string                 `json:"id"`
+	UserID string                 `json:"user_id"`
+	Title  *string                `json:"title"`
+	Query  *domain.SmartListQuery `json:"query"`
+}
+
+type SmartList interface {
+	// Create and Update fail with ErrInvalidSmartListQuery when relative dates or the sort of the query are invalid.
+	Create(ctx context.Context, inp CreateSmartListInput) (domain.SmartList, error)
+	Update(ctx context.Context, inp UpdateSmartListInput) (domain.SmartList, error)
+	Delete(ctx context.Context, listID, userID string) error
+	GetByID(ctx context.Context, listID, userID string) (domain.SmartList, error)
+	GetList(ctx context.Context, userID string) ([]domain.SmartList, error)
+	// GetTasks runs the query of the list with relative dates resolved at the current time.
+	GetTasks(ctx context.Context, listID, userID string, pagination domain.PaginationQuery) (TaskListResult, error)
 }
 
 type Deps struct {
@@ -96,18 +468,49 @@ type Deps struct {
 	AccessTokenTTL time.Duration
 	TokenManager   auth.TokenManager
 	Hasher         hash.PasswordHasher
+	FileStorage    storage.FileStorage
+	// TaskDescriptionMaxLength is the limit of task descriptions in characters
+	TaskDescriptionMaxLength int
+	// UndoWindow is how long task updates and deletes can be undone
+	UndoWindow  time.Duration
+	Attachments AttachmentLimits
 }
 
 type Services struct {
-	Users      User
-	Tasks      Task
-	Categories Category
+	Users          User
+	Tasks          Task
+	Categories     Category
+	ChecklistItems ChecklistItem
+	Tags           Tag
+	Trash          Trash
+	TimeEntries    TimeEntry
+	Comments       Comment
+	Attachments    Attachment
+	Activity       Activity
+	Templates      Template
+	SmartLists     SmartList
 }
 
 func NewServices(deps Deps) *Services {
+	tasksService := NewTaskService(
+		deps.Repos.Transactor, deps.Repos.Task, deps.Repos.Category, deps.Repos.Tag, deps.Repos.TaskDependency,
+		deps.Repos.Attachment, deps.Repos.Activity, deps.Repos.Undo, deps.TaskDescriptionMaxLength, deps.UndoWindow,
+	)
+
 	return &Services{
-		Users:      NewUserService(deps.Repos.User, deps.AccessTokenTTL, deps.TokenManager, deps.Hasher),
-		Tasks:      NewTaskService(deps.Repos.Task, deps.Repos.Category),
-		Categories: NewCategoryService(deps.Repos.Category),
+		Users:          NewUserService(deps.Repos.User, deps.AccessTokenTTL, deps.TokenManager, deps.Hasher),
+		Tasks:          tasksService,
+		Categories:     NewCategoryService(deps.Repos.Category),
+		ChecklistItems: NewChecklistItemService(deps.Repos.Transactor, deps.Repos.ChecklistItem, deps.Repos.Task, tasksService),
+		Tags:           NewTagService(deps.Repos.Tag),
+		Trash:          NewTrashService(deps.Repos.Task, deps.Repos.Category, deps.Repos.Attachment, deps.FileStorage),
+		TimeEntries:    NewTimeEntryService(deps.Repos.TimeEntry, deps.Repos.Task),
+		Comments:       NewCommentService(deps.Repos.Comment, deps.Repos.Task),
+		Activity:       NewActivityService(deps.Repos.Activity, deps.Repos.Task),
+		Templates:      NewTemplateService(deps.Repos.Template, deps.Repos.Category, tasksService),
+		SmartLists:     NewSmartListService(deps.Repos.SmartList, tasksService),
+		Attachments: NewAttachmentService(
+			deps.Repos.Attachment, deps.Repos.Task, deps.FileStorage, deps.Attachments,
+		),
 	}
 }
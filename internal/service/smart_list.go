@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type SmartListService struct {
+	repo         repository.SmartListRepository
+	tasksService Task
+}
+
+func NewSmartListService(repo repository.SmartListRepository, tasksService Task) *SmartListService {
+	return &SmartListService{repo: repo, tasksService: tasksService}
+}
+
+func (s *SmartListService) Create(ctx context.Context, inp CreateSmartListInput) (domain.SmartList, error) {
+	if err := checkSmartListQuery(inp.Query); err != nil {
+		return domain.SmartList{}, err
+	}
+
+	list := domain.SmartList{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		UserID:    inp.UserID,
+		Title:     inp.Title,
+		Query:     inp.Query,
+	}
+
+	return s.repo.Create(ctx, list)
+}
+
+func (s *SmartListService) Update(ctx context.Context, inp UpdateSmartListInput) (domain.SmartList, error) {
+	_, err := s.repo.GetByID(ctx, inp.ID, inp.UserID)
+	if err != nil {
+		return domain.SmartList{}, err
+	}
+
+	if inp.Title == nil && inp.Query == nil {
+		return domain.SmartList{}, customErrors.ErrNoUpdateFields
+	}
+	if inp.Query != nil {
+		if err := checkSmartListQuery(*inp.Query); err != nil {
+			return domain.SmartList{}, err
+		}
+	}
+
+	return s.repo.Update(ctx, repository.UpdateSmartListInput{
+		ID:        inp.ID,
+		UpdatedAt: time.Now(),
+		Title:     inp.Title,
+		Query:     inp.Query,
+	})
+}
+
+func (s *SmartListService) Delete(ctx context.Context, listID, userID string) error {
+	_, err := s.repo.GetByID(ctx, listID, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, listID)
+}
+
+func (s *SmartListService) GetByID(ctx context.Context, listID, userID string) (domain.SmartList, error) {
+	return s.repo.GetByID(ctx, listID, userID)
+}
+
+func (s *SmartListService) GetList(ctx context.Context, userID string) ([]domain.SmartList, error) {
+	return s.repo.GetListByUserID(ctx, userID)
+}
+
+func (s *SmartListService) GetTasks(
+	ctx context.Context, listID, userID string, pagination domain.PaginationQuery,
+) (TaskListResult, error) {
+	list, err := s.repo.GetByID(ctx, listID, userID)
+	if err != nil {
+		return TaskListResult{}, err
+	}
+
+	query, err := list.Query.TasksQuery(time.Now())
+	if err != nil {
+		return TaskListResult{}, fmt.Errorf("%w: %s", customErrors.ErrInvalidSmartListQuery, err.Error())
+	}
+	query.PaginationQuery = pagination
+
+	return s.tasksService.GetList(ctx, userID, query)
+}
+
+// checkSmartListQuery makes sure the relative dates and the sort of the query can be resolved.
+func checkSmartListQuery(listQuery domain.SmartListQuery) error {
+	query, err := listQuery.TasksQuery(time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %s", customErrors.ErrInvalidSmartListQuery, err.Error())
+	}
+	if query.Q == "" && query.SortsBy(domain.TaskSortRelevance) {
+		return fmt.Errorf("%w: relevance sort can be used only with q", customErrors.ErrInvalidSmartListQuery)
+	}
+	if query.Filter != "" {
+		if _, err := domain.ParseTaskFilter(query.Filter); err != nil {
+			return fmt.Errorf("%w: filter: %s", customErrors.ErrInvalidSmartListQuery, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type TagService struct {
+	repo repository.TagRepository
+}
+
+func NewTagService(repo repository.TagRepository) *TagService {
+	return &TagService{repo: repo}
+}
+
+func (s *TagService) Create(ctx context.Context, inp CreateTagInput) (domain.Tag, error) {
+	tag := domain.Tag{
+		UserID:    inp.UserID,
+		CreatedAt: time.Now(),
+		Title:     inp.Title,
+		Color:     inp.Color,
+	}
+
+	return s.repo.Create(ctx, tag)
+}
+
+func (s *TagService) Update(ctx context.Context, inp UpdateTagInput) (domain.Tag, error) {
+	_, err := s.repo.GetByID(ctx, inp.ID, inp.UserID)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+
+	if inp.Title == nil && inp.Color == nil {
+		return domain.Tag{}, customErrors.ErrNoUpdateFields
+	}
+
+	updateInput := repository.UpdateTagInput{
+		ID:    inp.ID,
+		Title: inp.Title,
+		Color: inp.Color,
+	}
+
+	return s.repo.Update(ctx, updateInput)
+}
+
+func (s *TagService) Delete(ctx context.Context, tagID, userID string) error {
+	_, err := s.repo.GetByID(ctx, tagID, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, tagID)
+}
+
+func (s *TagService) GetList(ctx context.Context, userID string) ([]domain.Tag, error) {
+	return s.repo.GetListByUserID(ctx, userID)
+}
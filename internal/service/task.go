@@ -2,27 +2,160 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
 	"math"
 	"time"
 	"todo_list_go/internal/domain"
 	"todo_list_go/internal/repository"
 	customErrors "todo_list_go/pkg/errors"
+	"unicode/utf8"
 )
 
 type TaskService struct {
-	repo         repository.TaskRepository
-	categoryRepo repository.CategoryRepository
+	transactor     repository.Transactor
+	repo           repository.TaskRepository
+	categoryRepo   repository.CategoryRepository
+	tagRepo        repository.TagRepository
+	dependencyRepo repository.TaskDependencyRepository
+	attachmentRepo repository.AttachmentRepository
+	activityRepo   repository.ActivityRepository
+	undoRepo       repository.UndoRepository
+	// descriptionMaxLength is the limit of descriptions in characters
+	descriptionMaxLength int
+	undoWindow           time.Duration
 }
 
-func NewTaskService(repo repository.TaskRepository, categoryRepo repository.CategoryRepository) *TaskService {
-	return &TaskService{repo: repo, categoryRepo: categoryRepo}
+func NewTaskService(
+	transactor repository.Transactor, repo repository.TaskRepository, categoryRepo repository.CategoryRepository,
+	tagRepo repository.TagRepository, dependencyRepo repository.TaskDependencyRepository,
+	attachmentRepo repository.AttachmentRepository, activityRepo repository.ActivityRepository,
+	undoRepo repository.UndoRepository, descriptionMaxLength int, undoWindow time.Duration,
+) *TaskService {
+	return &TaskService{
+		transactor:           transactor,
+		repo:                 repo,
+		categoryRepo:         categoryRepo,
+		tagRepo:              tagRepo,
+		dependencyRepo:       dependencyRepo,
+		attachmentRepo:       attachmentRepo,
+		activityRepo:         activityRepo,
+		undoRepo:             undoRepo,
+		undoWindow:           undoWindow,
+		descriptionMaxLength: descriptionMaxLength,
+	}
 }
 
 func (s *TaskService) Create(ctx context.Context, inp CreateTaskInput) (TaskOutput, error) {
-	_, err := s.categoryRepo.GetByID(ctx, inp.CategoryID, inp.UserID)
+	var createdTask repository.TaskOutput
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := s.newTask(ctx, inp, newTaskPositions(s.repo))
+		if err != nil {
+			return err
+		}
+		createdTask, err = s.repo.Create(ctx, task)
+		if err != nil {
+			return err
+		}
+
+		if len(inp.TagIDs) > 0 {
+			if err := s.tagRepo.SetTaskTags(ctx, createdTask.ID, inp.TagIDs); err != nil {
+				return err
+			}
+		}
+		activity := newTaskActivity(
+			createdTask.ID, inp.UserID, domain.ActivityCreated, createdTaskChanges(createdTask, inp.TagIDs),
+		)
+		return s.activityRepo.Create(ctx, activity)
+	})
 	if err != nil {
 		return TaskOutput{}, err
 	}
+	tasks := []repository.TaskOutput{createdTask}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return TaskOutput{}, err
+	}
+
+	return TaskOutput(tasks[0]), nil
+}
+
+func (s *TaskService) CreateList(ctx context.Context, inps []CreateTaskInput) ([]TaskOutput, error) {
+	var createdTasks []repository.TaskOutput
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		positions := newTaskPositions(s.repo)
+		createInputs := make([]repository.CreateTaskInput, len(inps))
+		for i, inp := range inps {
+			task, err := s.newTask(ctx, inp, positions)
+			if err != nil {
+				return err
+			}
+			createInputs[i] = repository.CreateTaskInput{Task: task, TagIDs: inp.TagIDs, ChecklistItems: inp.ChecklistItems}
+		}
+
+		var err error
+		createdTasks, err = s.repo.CreateList(ctx, createInputs)
+		if err != nil {
+			return err
+		}
+
+		activities := make([]domain.Activity, len(createdTasks))
+		for i, task := range createdTasks {
+			activities[i] = newTaskActivity(
+				task.ID, inps[i].UserID, domain.ActivityCreated, createdTaskChanges(task, inps[i].TagIDs),
+			)
+		}
+		return s.activityRepo.Create(ctx, activities...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadRelations(ctx, createdTasks); err != nil {
+		return nil, err
+	}
+
+	tasksOutput := make([]TaskOutput, len(createdTasks))
+	for i, task := range createdTasks {
+		tasksOutput[i] = TaskOutput(task)
+	}
+	return tasksOutput, nil
+}
+
+// newTask validates the input and builds the task placed at the end of its category.
+func (s *TaskService) newTask(ctx context.Context, inp CreateTaskInput, positions *taskPositions) (domain.Task, error) {
+	if err := s.checkDescription(inp.Description); err != nil {
+		return domain.Task{}, err
+	}
+
+	_, err := s.categoryRepo.GetByID(ctx, inp.CategoryID, inp.UserID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	priority, err := domain.ParseTaskPriority(inp.Priority)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	status, err := newTaskStatus(inp.Status, inp.Completed)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	due, err := newTaskDue(inp.DueDate, inp.DueTime, inp.DueTimezone)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	if err := s.checkTags(ctx, inp.TagIDs, inp.UserID); err != nil {
+		return domain.Task{}, err
+	}
+
+	// new tasks go to the end of the category
+	position, err := positions.next(ctx, inp.CategoryID)
+	if err != nil {
+		return domain.Task{}, err
+	}
 
 	task := domain.Task{
 		CreatedAt:   time.Now(),
@@ -31,57 +164,280 @@ func (s *TaskService) Create(ctx context.Context, inp CreateTaskInput) (TaskOutp
 		CategoryID:  inp.CategoryID,
 		Title:       inp.Title,
 		Description: inp.Description,
-		Completed:   inp.Completed,
+		Status:      status,
+		Priority:    priority,
+		DueDate:     due.Date,
+		DueTime:     due.Time,
+		DueTimezone: due.Timezone,
+		DueAt:       due.At,
+		Position:    position,
 	}
-	createdTask, err := s.repo.Create(ctx, task)
-	if err != nil {
-		return TaskOutput{}, err
+	if inp.EstimateMinutes > 0 {
+		task.EstimateMinutes = &inp.EstimateMinutes
+	}
+	if inp.Recurrence != nil {
+		if due.Date == nil {
+			return domain.Task{}, customErrors.ErrRecurrenceDueDate
+		}
+		rule := inp.Recurrence.String()
+		seriesID := uuid.NewString()
+		task.RecurrenceRule = &rule
+		task.SeriesID = &seriesID
 	}
 
-	return TaskOutput(createdTask), nil
+	return task, nil
 }
 
-func (s *TaskService) Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput, error) {
-	_, err := s.repo.GetByID(ctx, inp.ID, inp.UserID)
+func (s *TaskService) Update(ctx context.Context, inp UpdateTaskInput) (TaskOutput, UndoToken, error) {
+	// the task row stays locked until the update is written, so the transition, blocker and recurrence
+	// decisions aren't made on a state a concurrent request has changed meanwhile;
+	// the next occurrence, tags and activity are written together with the task, so they can't get lost
+	// the undo token is stored with the change, so an applied change always has one
+	var task, updatedTask repository.TaskOutput
+	var next *domain.Task
+	var undoToken UndoToken
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		task, err = s.repo.GetByIDForUpdate(ctx, inp.ID, inp.UserID)
+		if err != nil {
+			return err
+		}
+		if inp.TagIDs != nil {
+			// tags before the update are needed for the activity log
+			tags, err := s.tagRepo.GetListByTaskIDs(ctx, []string{task.ID})
+			if err != nil {
+				return err
+			}
+			task.Tags = tags[task.ID]
+		}
+
+		updateInput, next, err := s.taskUpdate(ctx, task, inp)
+		if err != nil {
+			return err
+		}
+
+		positions := newTaskPositions(s.repo)
+		if inp.CategoryID != nil && *inp.CategoryID != task.Category.ID {
+			// a task moved to another category goes to its end
+			position, err := positions.next(ctx, *inp.CategoryID)
+			if err != nil {
+				return err
+			}
+			updateInput.Position = &position
+		}
+		if next != nil {
+			next.Position, err = positions.next(ctx, next.CategoryID)
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedTask, err = s.repo.Update(ctx, updateInput)
+		if err != nil {
+			return err
+		}
+		var createdNext repository.TaskOutput
+		if next != nil {
+			createdNext, err = s.repo.CreateNextOccurrence(ctx, updatedTask.ID, *next)
+			if err != nil {
+				return err
+			}
+		}
+
+		var tagChanges []domain.FieldChange
+		if inp.TagIDs != nil {
+			if err := s.checkTags(ctx, inp.TagIDs, inp.UserID); err != nil {
+				return err
+			}
+			if err := s.tagRepo.SetTaskTags(ctx, updatedTask.ID, inp.TagIDs); err != nil {
+				return err
+			}
+			if change, ok := tagsChange(task.Tags, inp.TagIDs); ok {
+				tagChanges = append(tagChanges, change)
+			}
+		}
+		if err := s.recordTaskUpdate(ctx, task, updatedTask, inp.UserID, tagChanges...); err != nil {
+			return err
+		}
+
+		snapshot := taskSnapshot(task, domain.TaskVersion{ID: task.ID, UpdatedAt: updateInput.UpdatedAt})
+		if inp.TagIDs != nil {
+			snapshot.TagIDs = taskTagIDs(task.Tags)
+		}
+		if next != nil {
+			snapshot.NextOccurrence = &domain.TaskVersion{ID: createdNext.ID, UpdatedAt: next.UpdatedAt}
+		}
+		undoToken, err = s.newUndoToken(ctx, inp.UserID, domain.UndoTaskUpdate, []domain.TaskSnapshot{snapshot})
+		return err
+	})
 	if err != nil {
-		return TaskOutput{}, err
+		return TaskOutput{}, UndoToken{}, err
 	}
+	tasks := []repository.TaskOutput{updatedTask}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return TaskOutput{}, UndoToken{}, err
+	}
+	updatedTask = tasks[0]
+	if next != nil {
+		// the recurrence rule has moved to the next occurrence
+		updatedTask.RecurrenceRule = nil
+	}
+
+	return TaskOutput(updatedTask), undoToken, nil
+}
 
-	if inp.Title == nil && inp.Description == nil && inp.CategoryID == nil && inp.Completed == nil {
-		return TaskOutput{}, customErrors.ErrNoUpdateFields
+// taskUpdate validates the update of the locked task and returns the repository input
+// with the next occurrence generated when the update completes a recurring task.
+func (s *TaskService) taskUpdate(
+	ctx context.Context, task repository.TaskOutput, inp UpdateTaskInput,
+) (repository.UpdateTaskInput, *domain.Task, error) {
+	dueChanged := inp.DueDate != nil || inp.DueTime != nil || inp.DueTimezone != nil
+	if inp.Title == nil && inp.Description == nil && inp.CategoryID == nil && inp.Completed == nil && inp.Status == nil &&
+		inp.Priority == nil && !dueChanged && inp.EstimateMinutes == nil && inp.TagIDs == nil {
+		return repository.UpdateTaskInput{}, nil, customErrors.ErrNoUpdateFields
 	}
 
+	if inp.Description != nil {
+		if err := s.checkDescription(*inp.Description); err != nil {
+			return repository.UpdateTaskInput{}, nil, err
+		}
+	}
 	if inp.CategoryID != nil {
-		_, err = s.categoryRepo.GetByID(ctx, *inp.CategoryID, inp.UserID)
+		_, err := s.categoryRepo.GetByID(ctx, *inp.CategoryID, inp.UserID)
 		if err != nil {
-			return TaskOutput{}, err
+			return repository.UpdateTaskInput{}, nil, err
 		}
 	}
 
 	updateInput := repository.UpdateTaskInput{
-		ID:          inp.ID,
-		UserID:      inp.UserID,
-		UpdatedAt:   time.Now(),
-		CategoryID:  inp.CategoryID,
-		Title:       inp.Title,
-		Description: inp.Description,
-		Completed:   inp.Completed,
+		ID:              inp.ID,
+		UserID:          inp.UserID,
+		UpdatedAt:       time.Now(),
+		CategoryID:      inp.CategoryID,
+		Title:           inp.Title,
+		Description:     inp.Description,
+		EstimateMinutes: inp.EstimateMinutes,
 	}
-	updatedTask, err := s.repo.Update(ctx, updateInput)
+	var err error
+	updateInput.Status, err = updatedTaskStatus(task.Status, inp.Status, inp.Completed)
 	if err != nil {
-		return TaskOutput{}, err
+		return repository.UpdateTaskInput{}, nil, err
+	}
+	if inp.Priority != nil {
+		priority, err := domain.ParseTaskPriority(*inp.Priority)
+		if err != nil {
+			return repository.UpdateTaskInput{}, nil, err
+		}
+		updateInput.Priority = &priority
+	}
+	if dueChanged {
+		due, err := mergeTaskDue(task, inp)
+		if err != nil {
+			return repository.UpdateTaskInput{}, nil, err
+		}
+		if due.Date == nil && task.RecurrenceRule != nil {
+			return repository.UpdateTaskInput{}, nil, customErrors.ErrRecurrenceDueDate
+		}
+		updateInput.Due = &due
+	}
+
+	if completesTask(task, updateInput) {
+		blockedTaskIDs, err := s.dependencyRepo.GetBlockedTaskIDs(ctx, []string{task.ID})
+		if err != nil {
+			return repository.UpdateTaskInput{}, nil, err
+		}
+		if len(blockedTaskIDs) > 0 {
+			return repository.UpdateTaskInput{}, nil, customErrors.ErrTaskBlocked
+		}
 	}
 
-	return TaskOutput(updatedTask), nil
+	// completing a recurring task generates its next occurrence
+	if completesTask(task, updateInput) && task.RecurrenceRule != nil {
+		next, err := nextOccurrence(task, updateInput, time.Now())
+		if err != nil {
+			return repository.UpdateTaskInput{}, nil, err
+		}
+		return updateInput, &next, nil
+	}
+
+	return updateInput, nil, nil
 }
 
-func (s *TaskService) Delete(ctx context.Context, taskID, userID string) error {
-	_, err := s.repo.GetByID(ctx, taskID, userID)
+func (s *TaskService) Delete(ctx context.Context, taskID, userID string) (UndoToken, error) {
+	task, err := s.repo.GetByID(ctx, taskID, userID)
 	if err != nil {
+		return UndoToken{}, err
+	}
+
+	deletedAt := time.Now()
+	var undoToken UndoToken
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Delete(ctx, taskID, deletedAt); err != nil {
+			return err
+		}
+		if err := s.activityRepo.Create(ctx, newTaskActivity(taskID, userID, domain.ActivityDeleted, nil)); err != nil {
+			return err
+		}
+
+		// deleting doesn't change updated_at
+		snapshot := taskSnapshot(task, domain.TaskVersion{ID: task.ID, UpdatedAt: task.UpdatedAt, DeletedAt: &deletedAt})
+		undoToken, err = s.newUndoToken(ctx, userID, domain.UndoTaskDelete, []domain.TaskSnapshot{snapshot})
 		return err
+	})
+	if err != nil {
+		return UndoToken{}, err
+	}
+
+	return undoToken, nil
+}
+
+func (s *TaskService) Restore(ctx context.Context, taskID, userID string) (TaskOutput, error) {
+	task, err := s.repo.GetDeletedByID(ctx, taskID, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	_, err = s.categoryRepo.GetByID(ctx, task.Category.ID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrCategoryNotFound) {
+			return TaskOutput{}, customErrors.ErrTaskCategoryDeleted
+		}
+		return TaskOutput{}, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Restore(ctx, taskID); err != nil {
+			return err
+		}
+		return s.activityRepo.Create(ctx, newTaskActivity(taskID, userID, domain.ActivityRestored, nil))
+	})
+	if err != nil {
+		return TaskOutput{}, err
 	}
 
-	return s.repo.Delete(ctx, taskID)
+	return s.GetByID(ctx, taskID, userID)
+}
+
+func (s *TaskService) GetDeletedList(
+	ctx context.Context, userID string, query domain.PaginationQuery,
+) (TaskListResult, error) {
+	tasks, count, err := s.repo.GetDeletedListByUserID(ctx, userID, query)
+	if err != nil {
+		return TaskListResult{}, err
+	}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return TaskListResult{}, err
+	}
+
+	tasksOutput := make([]TaskOutput, len(tasks))
+	for i, task := range tasks {
+		tasksOutput[i] = TaskOutput(task)
+	}
+	return TaskListResult{
+		Items:      tasksOutput,
+		TotalItems: count,
+		TotalPages: int(math.Ceil(float64(count) / float64(query.Limit))),
+	}, nil
 }
 
 func (s *TaskService) GetByID(ctx context.Context, taskID, userID string) (TaskOutput, error) {
@@ -90,14 +446,29 @@ func (s *TaskService) GetByID(ctx context.Context, taskID, userID string) (TaskO
 		return TaskOutput{}, err
 	}
 
-	return TaskOutput(task), nil
+	tasks := []repository.TaskOutput{task}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return TaskOutput{}, err
+	}
+
+	return TaskOutput(tasks[0]), nil
 }
 
 func (s *TaskService) GetList(ctx context.Context, userID string, query domain.GetTasksQuery) (TaskListResult, error) {
+	if err := checkTaskFilter(query.Filter); err != nil {
+		return TaskListResult{}, err
+	}
+	if query.CursorMode() {
+		return s.getCursorList(ctx, userID, query)
+	}
+
 	tasks, count, err := s.repo.GetListByUserID(ctx, userID, query)
 	if err != nil {
 		return TaskListResult{}, err
 	}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return TaskListResult{}, err
+	}
 
 	totalPages := int(math.Ceil(float64(count) / float64(query.Limit)))
 	tasksOutput := make([]TaskOutput, len(tasks))
@@ -110,3 +481,194 @@ func (s *TaskService) GetList(ctx context.Context, userID string, query domain.G
 		TotalPages: totalPages,
 	}, nil
 }
+
+// checkTaskFilter returns ErrInvalidTaskFilter with the position of the problem when the filter can't be parsed.
+func checkTaskFilter(filter string) error {
+	if filter == "" {
+		return nil
+	}
+	if _, err := domain.ParseTaskFilter(filter); err != nil {
+		return fmt.Errorf("%w: %s", customErrors.ErrInvalidTaskFilter, err.Error())
+	}
+	return nil
+}
+
+func (s *TaskService) getCursorList(ctx context.Context, userID string, query domain.GetTasksQuery) (TaskListResult, error) {
+	var after *domain.Cursor
+	if *query.Cursor != "" {
+		cursor, err := domain.DecodeCursor(*query.Cursor)
+		if err != nil {
+			return TaskListResult{}, customErrors.ErrInvalidCursor
+		}
+		// the cursor holds values of the sort fields, so it can't be used with another sort
+		if cursor.Sort != query.SortKey() || len(cursor.Values) != len(query.SortFields()) || uuid.Validate(cursor.ID) != nil {
+			return TaskListResult{}, customErrors.ErrInvalidCursor
+		}
+		after = &cursor
+	}
+
+	tasks, next, err := s.repo.GetCursorListByUserID(ctx, userID, query, after)
+	if err != nil {
+		return TaskListResult{}, err
+	}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return TaskListResult{}, err
+	}
+
+	tasksOutput := make([]TaskOutput, len(tasks))
+	for i, task := range tasks {
+		tasksOutput[i] = TaskOutput(task)
+	}
+	result := TaskListResult{Items: tasksOutput}
+	if next != nil {
+		nextCursor := next.Encode()
+		result.NextCursor = &nextCursor
+	}
+
+	return result, nil
+}
+
+// checkDescription counts characters rather than bytes, the limit is set in the config.
+func (s *TaskService) checkDescription(description string) error {
+	if utf8.RuneCountInString(description) > s.descriptionMaxLength {
+		return customErrors.ErrTaskDescriptionTooLong
+	}
+	return nil
+}
+
+// checkTags returns ErrTagNotFound when any of the tags doesn't belong to the user.
+func (s *TaskService) checkTags(ctx context.Context, tagIDs []string, userID string) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	uniqueTagIDs := make(map[string]bool, len(tagIDs))
+	for _, tagID := range tagIDs {
+		uniqueTagIDs[tagID] = true
+	}
+
+	tags, err := s.tagRepo.GetListByIDs(ctx, tagIDs, userID)
+	if err != nil {
+		return err
+	}
+	if len(tags) != len(uniqueTagIDs) {
+		return customErrors.ErrTagNotFound
+	}
+
+	return nil
+}
+
+// loadRelations sets tags, dependencies and attachments of the tasks with a query per relation.
+func (s *TaskService) loadRelations(ctx context.Context, tasks []repository.TaskOutput) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]string, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+	}
+
+	tags, err := s.tagRepo.GetListByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	blockedBy, blocks, err := s.dependencyRepo.GetListByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := s.attachmentRepo.GetListByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	for i, task := range tasks {
+		tasks[i].Tags = tags[task.ID]
+		if tasks[i].Tags == nil {
+			tasks[i].Tags = make([]domain.Tag, 0)
+		}
+		tasks[i].BlockedBy = blockedBy[task.ID]
+		tasks[i].Blocks = blocks[task.ID]
+		tasks[i].Attachments = attachments[task.ID]
+	}
+
+	return nil
+}
+
+// newTaskStatus returns the status of a created task.
+func newTaskStatus(status string, completed bool) (domain.TaskStatus, error) {
+	if status == "" && completed {
+		return domain.TaskStatusDone, nil
+	}
+
+	return domain.ParseTaskStatus(status)
+}
+
+// updatedTaskStatus returns the status set by the update, it is nil when the update keeps the status.
+func updatedTaskStatus(current domain.TaskStatus, status *string, completed *bool) (*domain.TaskStatus, error) {
+	var next domain.TaskStatus
+	switch {
+	case status != nil:
+		var err error
+		next, err = domain.ParseTaskStatus(*status)
+		if err != nil {
+			return nil, err
+		}
+	case completed != nil && *completed:
+		next = domain.TaskStatusDone
+	case completed != nil && current == domain.TaskStatusDone:
+		next = domain.TaskStatusTodo
+	default:
+		return nil, nil
+	}
+
+	if !current.CanTransitionTo(next) {
+		return nil, fmt.Errorf("%w: %s to %s", customErrors.ErrTaskStatusTransition, current, next)
+	}
+
+	return &next, nil
+}
+
+// completesTask reports whether the update marks the task done.
+func completesTask(task repository.TaskOutput, inp repository.UpdateTaskInput) bool {
+	return inp.Status != nil && *inp.Status == domain.TaskStatusDone && task.Status != domain.TaskStatusDone
+}
+
+func newTaskDue(date, clock, timezone string) (domain.TaskDue, error) {
+	if date == "" && (clock != "" || timezone != "") {
+		return domain.TaskDue{}, customErrors.ErrDueDateRequired
+	}
+
+	return domain.NewTaskDue(date, clock, timezone)
+}
+
+// mergeTaskDue applies due fields from the update input on top of the stored ones.
+func mergeTaskDue(task repository.TaskOutput, inp UpdateTaskInput) (domain.TaskDue, error) {
+	var date, clock, timezone string
+	if task.DueDate != nil {
+		date = task.DueDate.Format(time.DateOnly)
+	}
+	if task.DueTime != nil {
+		clock = *task.DueTime
+	}
+	if task.DueTimezone != nil {
+		timezone = *task.DueTimezone
+	}
+
+	if inp.DueDate != nil {
+		date = *inp.DueDate
+		if date == "" {
+			clock, timezone = "", ""
+		}
+	}
+	if inp.DueTime != nil {
+		clock = *inp.DueTime
+	}
+	if inp.DueTimezone != nil {
+		timezone = *inp.DueTimezone
+	}
+
+	return newTaskDue(date, clock, timezone)
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+)
+
+// Names of the task fields recorded in the activity log.
+const (
+	taskFieldCategoryID      = "category_id"
+	taskFieldTitle           = "title"
+	taskFieldDescription     = "description"
+	taskFieldStatus          = "status"
+	taskFieldPriority        = "priority"
+	taskFieldDueDate         = "due_date"
+	taskFieldDueTime         = "due_time"
+	taskFieldDueTimezone     = "due_timezone"
+	taskFieldEstimateMinutes = "estimate_minutes"
+	taskFieldTags            = "tag_ids"
+	taskFieldPosition        = "position"
+)
+
+// taskFieldValue returns the value of the task field as it's stored in the activity log, nil stands for an empty value.
+type taskFieldValue func(task repository.TaskOutput) any
+
+var trackedTaskFields = []struct {
+	name  string
+	value taskFieldValue
+}{
+	{taskFieldCategoryID, func(task repository.TaskOutput) any { return task.Category.ID }},
+	{taskFieldTitle, func(task repository.TaskOutput) any { return task.Title }},
+	{taskFieldDescription, func(task repository.TaskOutput) any { return emptyToNil(task.Description) }},
+	{taskFieldStatus, func(task repository.TaskOutput) any { return task.Status.String() }},
+	{taskFieldPriority, func(task repository.TaskOutput) any { return task.Priority.String() }},
+	{taskFieldDueDate, func(task repository.TaskOutput) any {
+		if task.DueDate == nil {
+			return nil
+		}
+		return task.DueDate.Format(time.DateOnly)
+	}},
+	{taskFieldDueTime, func(task repository.TaskOutput) any { return derefOrNil(task.DueTime) }},
+	{taskFieldDueTimezone, func(task repository.TaskOutput) any { return derefOrNil(task.DueTimezone) }},
+	{taskFieldEstimateMinutes, func(task repository.TaskOutput) any { return derefOrNil(task.EstimateMinutes) }},
+	{taskFieldPosition, func(task repository.TaskOutput) any { return task.Position }},
+}
+
+// taskChanges compares the tracked fields of the task before and after the change, tags are compared separately.
+func taskChanges(before, after repository.TaskOutput) domain.FieldChanges {
+	changes := make(domain.FieldChanges, 0)
+	for _, field := range trackedTaskFields {
+		beforeValue, afterValue := field.value(before), field.value(after)
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			changes = append(changes, domain.FieldChange{Field: field.name, Before: beforeValue, After: afterValue})
+		}
+	}
+	return changes
+}
+
+// createdTaskChanges lists the fields of the created task that aren't empty.
+func createdTaskChanges(task repository.TaskOutput, tagIDs []string) domain.FieldChanges {
+	changes := make(domain.FieldChanges, 0)
+	for _, field := range trackedTaskFields {
+		if value := field.value(task); value != nil {
+			changes = append(changes, domain.FieldChange{Field: field.name, After: value})
+		}
+	}
+	if len(tagIDs) > 0 {
+		changes = append(changes, domain.FieldChange{Field: taskFieldTags, After: sortedIDs(tagIDs)})
+	}
+	return changes
+}
+
+// tagsChange returns the change of the task tags, ok is false when the tags are the same.
+func tagsChange(before []domain.Tag, afterIDs []string) (domain.FieldChange, bool) {
+	beforeIDs := make([]string, len(before))
+	for i, tag := range before {
+		beforeIDs[i] = tag.ID
+	}
+	beforeIDs, afterIDs = sortedIDs(beforeIDs), sortedIDs(afterIDs)
+	if slices.Equal(beforeIDs, afterIDs) {
+		return domain.FieldChange{}, false
+	}
+
+	return domain.FieldChange{Field: taskFieldTags, Before: idsOrNil(beforeIDs), After: idsOrNil(afterIDs)}, true
+}
+
+// taskUpdateAction tells completing and moving tasks apart from other updates.
+func taskUpdateAction(changes domain.FieldChanges) domain.ActivityAction {
+	for _, change := range changes {
+		if change.Field == taskFieldStatus && change.After == domain.TaskStatusDone.String() {
+			return domain.ActivityCompleted
+		}
+	}
+	for _, field := range changes.Fields() {
+		if field != taskFieldCategoryID && field != taskFieldPosition {
+			return domain.ActivityUpdated
+		}
+	}
+	return domain.ActivityMoved
+}
+
+func newTaskActivity(taskID, userID string, action domain.ActivityAction, changes domain.FieldChanges) domain.Activity {
+	return domain.Activity{
+		CreatedAt: time.Now(),
+		UserID:    userID,
+		TaskID:    taskID,
+		Action:    action,
+		Changes:   changes,
+	}
+}
+
+// recordTaskUpdate records the changes of the updated task, updates that change nothing aren't recorded.
+func (s *TaskService) recordTaskUpdate(
+	ctx context.Context, before, after repository.TaskOutput, userID string, extra ...domain.FieldChange,
+) error {
+	changes := append(taskChanges(before, after), extra...)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return s.activityRepo.Create(ctx, newTaskActivity(after.ID, userID, taskUpdateAction(changes), changes))
+}
+
+func sortedIDs(ids []string) []string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+func idsOrNil(ids []string) any {
+	if len(ids) == 0 {
+		return nil
+	}
+	return ids
+}
+
+func emptyToNil(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func derefOrNil[T any](value *T) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// Archive hides the task from the task list, archiving an archived task keeps its archive time.
+func (s *TaskService) Archive(ctx context.Context, taskID, userID string) (TaskOutput, error) {
+	task, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+	if task.ArchivedAt != nil {
+		return s.GetByID(ctx, taskID, userID)
+	}
+
+	now := time.Now()
+	if err := s.repo.SetArchived(ctx, taskID, &now, now); err != nil {
+		return TaskOutput{}, err
+	}
+
+	return s.GetByID(ctx, taskID, userID)
+}
+
+func (s *TaskService) Unarchive(ctx context.Context, taskID, userID string) (TaskOutput, error) {
+	task, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+	if task.ArchivedAt == nil {
+		return s.GetByID(ctx, taskID, userID)
+	}
+
+	if err := s.repo.SetArchived(ctx, taskID, nil, time.Now()); err != nil {
+		return TaskOutput{}, err
+	}
+
+	return s.GetByID(ctx, taskID, userID)
+}
+
+func (s *TaskService) ArchiveCompleted(ctx context.Context, categoryID, userID string) (int64, error) {
+	_, err := s.categoryRepo.GetByID(ctx, categoryID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.repo.ArchiveCompleted(ctx, categoryID, time.Now())
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (s *TaskService) Bulk(ctx context.Context, inp BulkTaskInput) ([]BulkTaskItemResult, UndoToken, error) {
+	var priority *domain.TaskPriority
+	switch inp.Action {
+	case BulkTaskMove:
+		_, err := s.categoryRepo.GetByID(ctx, inp.CategoryID, inp.UserID)
+		if err != nil {
+			return nil, UndoToken{}, err
+		}
+	case BulkTaskUpdate:
+		if inp.Priority == nil && inp.DueDate == nil && inp.DueTime == nil && inp.DueTimezone == nil {
+			return nil, UndoToken{}, customErrors.ErrNoUpdateFields
+		}
+		if inp.Priority != nil {
+			taskPriority, err := domain.ParseTaskPriority(*inp.Priority)
+			if err != nil {
+				return nil, UndoToken{}, err
+			}
+			priority = &taskPriority
+		}
+	}
+
+	taskIDs, err := s.bulkTaskIDs(ctx, inp)
+	if err != nil {
+		return nil, UndoToken{}, err
+	}
+
+	tasks, err := s.repo.GetListByIDs(ctx, taskIDs, inp.UserID)
+	if err != nil {
+		return nil, UndoToken{}, err
+	}
+	tasksByID := make(map[string]repository.TaskOutput, len(tasks))
+	for _, task := range tasks {
+		tasksByID[task.ID] = task
+	}
+
+	blocked := make(map[string]bool)
+	if inp.Action == BulkTaskComplete {
+		blockedTaskIDs, err := s.dependencyRepo.GetBlockedTaskIDs(ctx, taskIDs)
+		if err != nil {
+			return nil, UndoToken{}, err
+		}
+		for _, taskID := range blockedTaskIDs {
+			blocked[taskID] = true
+		}
+	}
+
+	now := time.Now()
+	results := make([]BulkTaskItemResult, len(taskIDs))
+	updates := make([]repository.UpdateTaskInput, 0, len(taskIDs))
+	nextOccurrences := make(map[string]domain.Task)
+	deleteIDs := make([]string, 0)
+	for i, taskID := range taskIDs {
+		results[i].TaskID = taskID
+
+		task, ok := tasksByID[taskID]
+		if !ok {
+			results[i].Err = customErrors.ErrTaskNotFound
+			continue
+		}
+
+		if inp.Action == BulkTaskDelete {
+			deleteIDs = append(deleteIDs, taskID)
+			continue
+		}
+
+		update, err := bulkTaskUpdate(task, inp, priority, now)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		if completesTask(task, update) && blocked[taskID] {
+			results[i].Err = customErrors.ErrTaskBlocked
+			continue
+		}
+		// completing a recurring task generates its next occurrence
+		if completesTask(task, update) && task.RecurrenceRule != nil {
+			next, err := nextOccurrence(task, update, now)
+			if err != nil {
+				results[i].Err = err
+				continue
+			}
+			nextOccurrences[taskID] = next
+		}
+		updates = append(updates, update)
+	}
+
+	createdIDs := make(map[string]string)
+	var undoToken UndoToken
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if len(deleteIDs) > 0 {
+			if err := s.repo.BulkDelete(ctx, deleteIDs, now); err != nil {
+				return err
+			}
+		}
+		if len(updates) > 0 {
+			if err := s.assignBulkPositions(ctx, inp, tasksByID, updates, nextOccurrences); err != nil {
+				return err
+			}
+			var err error
+			createdIDs, err = s.repo.BulkUpdate(ctx, updates, nextOccurrences)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := s.recordBulkActivities(ctx, inp.UserID, tasksByID, deleteIDs, updates); err != nil {
+			return err
+		}
+
+		// the undo token is stored with the changes, so applied changes always have one
+		snapshots := make([]domain.TaskSnapshot, 0, len(deleteIDs)+len(updates))
+		for _, taskID := range deleteIDs {
+			task := tasksByID[taskID]
+			snapshots = append(snapshots,
+				taskSnapshot(task, domain.TaskVersion{ID: taskID, UpdatedAt: task.UpdatedAt, DeletedAt: &now}))
+		}
+		for _, update := range updates {
+			snapshot := taskSnapshot(tasksByID[update.ID], domain.TaskVersion{ID: update.ID, UpdatedAt: now})
+			if createdID, ok := createdIDs[update.ID]; ok {
+				snapshot.NextOccurrence = &domain.TaskVersion{ID: createdID, UpdatedAt: nextOccurrences[update.ID].UpdatedAt}
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		var err error
+		undoToken, err = s.newUndoToken(ctx, inp.UserID, domain.UndoTaskBulk, snapshots)
+		return err
+	})
+	if err != nil {
+		return nil, UndoToken{}, err
+	}
+
+	return results, undoToken, nil
+}
+
+// assignBulkPositions puts moved tasks and next occurrences to the end of their categories in the requested order.
+func (s *TaskService) assignBulkPositions(
+	ctx context.Context, inp BulkTaskInput, tasksByID map[string]repository.TaskOutput,
+	updates []repository.UpdateTaskInput, nextOccurrences map[string]domain.Task,
+) error {
+	positions := newTaskPositions(s.repo)
+	for i, update := range updates {
+		if inp.Action == BulkTaskMove && tasksByID[update.ID].Category.ID != inp.CategoryID {
+			position, err := positions.next(ctx, inp.CategoryID)
+			if err != nil {
+				return err
+			}
+			updates[i].Position = &position
+		}
+		if next, ok := nextOccurrences[update.ID]; ok {
+			var err error
+			next.Position, err = positions.next(ctx, next.CategoryID)
+			if err != nil {
+				return err
+			}
+			nextOccurrences[update.ID] = next
+		}
+	}
+
+	return nil
+}
+
+// recordBulkActivities records the deletes and compares the updated tasks with their state before the update.
+func (s *TaskService) recordBulkActivities(
+	ctx context.Context, userID string, before map[string]repository.TaskOutput, deleteIDs []string,
+	updates []repository.UpdateTaskInput,
+) error {
+	activities := make([]domain.Activity, 0, len(deleteIDs)+len(updates))
+	for _, taskID := range deleteIDs {
+		activities = append(activities, newTaskActivity(taskID, userID, domain.ActivityDeleted, nil))
+	}
+
+	if len(updates) > 0 {
+		updatedIDs := make([]string, len(updates))
+		for i, update := range updates {
+			updatedIDs[i] = update.ID
+		}
+		updatedTasks, err := s.repo.GetListByIDs(ctx, updatedIDs, userID)
+		if err != nil {
+			return err
+		}
+		for _, task := range updatedTasks {
+			changes := taskChanges(before[task.ID], task)
+			if len(changes) > 0 {
+				activities = append(activities, newTaskActivity(task.ID, userID, taskUpdateAction(changes), changes))
+			}
+		}
+	}
+
+	return s.activityRepo.Create(ctx, activities...)
+}
+
+// bulkTaskIDs returns unique ids of the target tasks in the requested order.
+func (s *TaskService) bulkTaskIDs(ctx context.Context, inp BulkTaskInput) ([]string, error) {
+	if inp.Filters != nil {
+		if err := checkTaskFilter(inp.Filters.Filter); err != nil {
+			return nil, err
+		}
+		// one extra task tells that the filters match too many tasks
+		taskIDs, err := s.repo.GetIDsByFilters(ctx, inp.UserID, *inp.Filters, MaxBulkTasks+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(taskIDs) > MaxBulkTasks {
+			return nil, customErrors.ErrTooManyBulkTasks
+		}
+		return taskIDs, nil
+	}
+
+	seen := make(map[string]bool, len(inp.TaskIDs))
+	taskIDs := make([]string, 0, len(inp.TaskIDs))
+	for _, taskID := range inp.TaskIDs {
+		if !seen[taskID] {
+			seen[taskID] = true
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	if len(taskIDs) > MaxBulkTasks {
+		return nil, customErrors.ErrTooManyBulkTasks
+	}
+
+	return taskIDs, nil
+}
+
+// bulkTaskUpdate builds the update of the task for all actions except delete.
+func bulkTaskUpdate(
+	task repository.TaskOutput, inp BulkTaskInput, priority *domain.TaskPriority, updatedAt time.Time,
+) (repository.UpdateTaskInput, error) {
+	update := repository.UpdateTaskInput{ID: task.ID, UserID: inp.UserID, UpdatedAt: updatedAt}
+
+	switch inp.Action {
+	case BulkTaskComplete, BulkTaskUncomplete:
+		completed := inp.Action == BulkTaskComplete
+		status, err := updatedTaskStatus(task.Status, nil, &completed)
+		if err != nil {
+			return repository.UpdateTaskInput{}, err
+		}
+		update.Status = status
+	case BulkTaskMove:
+		update.CategoryID = &inp.CategoryID
+	case BulkTaskUpdate:
+		update.Priority = priority
+		if inp.DueDate != nil || inp.DueTime != nil || inp.DueTimezone != nil {
+			due, err := mergeTaskDue(task, UpdateTaskInput{DueDate: inp.DueDate, DueTime: inp.DueTime, DueTimezone: inp.DueTimezone})
+			if err != nil {
+				return repository.UpdateTaskInput{}, err
+			}
+			if due.Date == nil && task.RecurrenceRule != nil {
+				return repository.UpdateTaskInput{}, customErrors.ErrRecurrenceDueDate
+			}
+			update.Due = &due
+		}
+	}
+
+	return update, nil
+}
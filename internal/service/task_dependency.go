@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (s *TaskService) AddDependency(ctx context.Context, taskID, blockerID, userID string) (TaskOutput, error) {
+	_, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	_, err = s.repo.GetByID(ctx, blockerID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			return TaskOutput{}, customErrors.ErrBlockerNotFound
+		}
+		return TaskOutput{}, err
+	}
+
+	// the lock keeps concurrent requests from adding the dependencies of a cycle one each
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.dependencyRepo.LockByUserID(ctx, userID); err != nil {
+			return err
+		}
+		// a task being completed keeps its row locked, so it isn't done with an open blocker added meanwhile
+		if _, err := s.repo.GetByIDForUpdate(ctx, taskID, userID); err != nil {
+			return err
+		}
+
+		blockers, err := s.dependencyRepo.GetBlockersByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if dependsOn(blockers, blockerID, taskID) {
+			return customErrors.ErrTaskDependencyCycle
+		}
+
+		return s.dependencyRepo.Create(ctx, taskID, blockerID)
+	})
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	return s.GetByID(ctx, taskID, userID)
+}
+
+func (s *TaskService) RemoveDependency(ctx context.Context, taskID, blockerID, userID string) error {
+	_, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.dependencyRepo.Delete(ctx, taskID, blockerID)
+}
+
+// dependsOn reports whether the task is blocked by the blocker directly or through other tasks,
+// every task is considered to depend on itself.
+func dependsOn(blockers map[string][]string, taskID, blockerID string) bool {
+	visited := map[string]bool{taskID: true}
+	queue := []string{taskID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == blockerID {
+			return true
+		}
+
+		for _, next := range blockers[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+// Move places the task between its new neighbours rewriting the task position only.
+// Positions of the category are rewritten when the new key gets too long.
+func (s *TaskService) Move(ctx context.Context, inp MoveTaskInput) (TaskOutput, error) {
+	task, err := s.repo.GetByID(ctx, inp.ID, inp.UserID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// neighbours are read under the lock, so concurrent moves don't compute the same key
+		if err := s.repo.LockPositions(ctx, task.Category.ID); err != nil {
+			return err
+		}
+		position, err := s.movePosition(ctx, task, inp)
+		if err != nil {
+			return err
+		}
+
+		movedTask, err := s.repo.Update(ctx, repository.UpdateTaskInput{
+			ID:        task.ID,
+			UserID:    inp.UserID,
+			UpdatedAt: time.Now(),
+			Position:  &position,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.recordTaskUpdate(ctx, task, movedTask, inp.UserID); err != nil {
+			return err
+		}
+
+		if domain.PositionNeedsRebalance(position) {
+			return s.repo.RebalancePositions(ctx, task.Category.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	return s.GetByID(ctx, task.ID, inp.UserID)
+}
+
+// movePosition returns a position between the new neighbours of the task no other task holds.
+func (s *TaskService) movePosition(ctx context.Context, task repository.TaskOutput, inp MoveTaskInput) (string, error) {
+	var prev, next string
+	var err error
+	if inp.AfterID != "" {
+		prev, err = s.neighbourPosition(ctx, task, inp.AfterID, inp.UserID)
+		if err != nil {
+			return "", err
+		}
+	}
+	if inp.BeforeID != "" {
+		next, err = s.neighbourPosition(ctx, task, inp.BeforeID, inp.UserID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// deleted and archived tasks keep their positions between the listed ones
+	if next == "" {
+		last, err := s.repo.GetLastPosition(ctx, task.Category.ID)
+		if err != nil {
+			return "", err
+		}
+		prev = max(prev, last)
+	} else if prev < next {
+		hidden, err := s.repo.GetFirstPositionBetween(ctx, task.Category.ID, prev, next)
+		if err != nil {
+			return "", err
+		}
+		if hidden != "" {
+			next = hidden
+		}
+	}
+
+	position, err := domain.PositionBetween(prev, next)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPositionRange) {
+			return "", customErrors.ErrInvalidTaskMove
+		}
+		return "", err
+	}
+
+	return position, nil
+}
+
+// neighbourPosition returns the position of another task of the same category.
+func (s *TaskService) neighbourPosition(ctx context.Context, task repository.TaskOutput, neighbourID, userID string) (string, error) {
+	if neighbourID == task.ID {
+		return "", customErrors.ErrTaskNeighbour
+	}
+
+	neighbour, err := s.repo.GetByID(ctx, neighbourID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			return "", customErrors.ErrTaskNeighbour
+		}
+		return "", err
+	}
+	if neighbour.Category.ID != task.Category.ID {
+		return "", customErrors.ErrTaskNeighbour
+	}
+
+	return neighbour.Position, nil
+}
+
+// taskPositions gives out positions at the end of categories,
+// the positions given for the same category follow each other.
+// It is used in the transaction writing the tasks, the categories stay locked until it ends.
+type taskPositions struct {
+	repo repository.TaskRepository
+	last map[string]string
+}
+
+func newTaskPositions(repo repository.TaskRepository) *taskPositions {
+	return &taskPositions{repo: repo, last: make(map[string]string)}
+}
+
+func (p *taskPositions) next(ctx context.Context, categoryID string) (string, error) {
+	last, ok := p.last[categoryID]
+	if !ok {
+		if err := p.repo.LockPositions(ctx, categoryID); err != nil {
+			return "", err
+		}
+		var err error
+		last, err = p.repo.GetLastPosition(ctx, categoryID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	position, err := domain.PositionBetween(last, "")
+	if err != nil {
+		return "", err
+	}
+	p.last[categoryID] = position
+
+	return position, nil
+}
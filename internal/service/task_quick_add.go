@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"todo_list_go/internal/domain"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (s *TaskService) QuickAdd(ctx context.Context, inp QuickAddTaskInput) (QuickAddTaskResult, error) {
+	location := time.UTC
+	if inp.Timezone != "" {
+		var err error
+		location, err = time.LoadLocation(inp.Timezone)
+		if err != nil {
+			return QuickAddTaskResult{}, err
+		}
+	}
+
+	parsed := domain.ParseQuickAdd(inp.Text, time.Now().In(location))
+	if parsed.Title == "" {
+		return QuickAddTaskResult{}, customErrors.ErrQuickAddTitleRequired
+	}
+
+	category, err := s.quickAddCategory(ctx, parsed.Category, inp)
+	if err != nil {
+		return QuickAddTaskResult{}, err
+	}
+
+	tags, err := s.quickAddTags(ctx, parsed.Tags, inp.UserID)
+	if err != nil {
+		return QuickAddTaskResult{}, err
+	}
+
+	result := QuickAddTaskResult{
+		Input: CreateTaskInput{
+			UserID:     inp.UserID,
+			CategoryID: category.ID,
+			Title:      parsed.Title,
+			Priority:   parsed.Priority,
+			DueDate:    parsed.DueDate,
+			DueTime:    parsed.DueTime,
+		},
+		Category: category,
+		Tags:     tags,
+	}
+	if parsed.DueTime != "" {
+		result.Input.DueTimezone = inp.Timezone
+	}
+	for _, tag := range tags {
+		result.Input.TagIDs = append(result.Input.TagIDs, tag.ID)
+	}
+	if inp.DryRun {
+		return result, nil
+	}
+
+	task, err := s.Create(ctx, result.Input)
+	if err != nil {
+		return QuickAddTaskResult{}, err
+	}
+	result.Task = &task
+
+	return result, nil
+}
+
+// quickAddCategory resolves the #category of the line, the category of the input is used for lines without one.
+func (s *TaskService) quickAddCategory(ctx context.Context, title string, inp QuickAddTaskInput) (domain.Category, error) {
+	if title != "" {
+		category, err := s.categoryRepo.GetByTitle(ctx, title, inp.UserID)
+		if errors.Is(err, customErrors.ErrCategoryNotFound) {
+			return domain.Category{}, fmt.Errorf("%w: %s", err, title)
+		}
+		return category, err
+	}
+	if inp.CategoryID == "" {
+		return domain.Category{}, customErrors.ErrQuickAddCategoryRequired
+	}
+
+	return s.categoryRepo.GetByID(ctx, inp.CategoryID, inp.UserID)
+}
+
+// quickAddTags returns the tags in the order of the titles, all of them must exist.
+func (s *TaskService) quickAddTags(ctx context.Context, titles []string, userID string) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0, len(titles))
+	if len(titles) == 0 {
+		return tags, nil
+	}
+
+	found, err := s.tagRepo.GetListByTitles(ctx, titles, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := make([]string, 0)
+	for _, title := range titles {
+		i := slices.IndexFunc(found, func(tag domain.Tag) bool { return strings.EqualFold(tag.Title, title) })
+		if i < 0 {
+			missing = append(missing, title)
+			continue
+		}
+		tags = append(tags, found[i])
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", customErrors.ErrTagNotFound, strings.Join(missing, ", "))
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+// maxOccurrences limits the number of occurrences returned by GetOccurrences.
+const maxOccurrences = 50
+
+func (s *TaskService) SetRecurrence(
+	ctx context.Context, taskID, userID string, recurrence domain.Recurrence,
+) (TaskOutput, error) {
+	task, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	// a stopped series is resumed from the given task
+	target, err := s.recurringTask(ctx, task, userID)
+	if err != nil && !errors.Is(err, customErrors.ErrTaskNotRecurring) {
+		return TaskOutput{}, err
+	}
+	if err != nil {
+		target = task
+	}
+
+	if target.DueDate == nil {
+		return TaskOutput{}, customErrors.ErrRecurrenceDueDate
+	}
+
+	seriesID := uuid.NewString()
+	if target.SeriesID != nil {
+		seriesID = *target.SeriesID
+	}
+	rule := recurrence.String()
+	err = s.repo.UpdateRecurrence(ctx, repository.UpdateTaskRecurrenceInput{
+		ID:             target.ID,
+		UpdatedAt:      time.Now(),
+		SeriesID:       seriesID,
+		RecurrenceRule: &rule,
+	})
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	return s.GetByID(ctx, target.ID, userID)
+}
+
+func (s *TaskService) StopRecurrence(ctx context.Context, taskID, userID string) (TaskOutput, error) {
+	task, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	target, err := s.recurringTask(ctx, task, userID)
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	err = s.repo.UpdateRecurrence(ctx, repository.UpdateTaskRecurrenceInput{
+		ID:        target.ID,
+		UpdatedAt: time.Now(),
+		SeriesID:  *target.SeriesID,
+	})
+	if err != nil {
+		return TaskOutput{}, err
+	}
+
+	return s.GetByID(ctx, target.ID, userID)
+}
+
+// GetOccurrences expects tasks recurring after completion to be completed when they are due.
+func (s *TaskService) GetOccurrences(ctx context.Context, taskID, userID string, count int) ([]domain.TaskDue, error) {
+	task, err := s.repo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.recurringTask(ctx, task, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence, err := domain.ParseRecurrence(*target.RecurrenceRule)
+	if err != nil {
+		return nil, err
+	}
+
+	count = min(count, maxOccurrences)
+	occurrences := make([]domain.TaskDue, 0, count)
+	due := taskDue(target)
+	for len(occurrences) < count {
+		due, err = nextTaskDue(due, recurrence, *due.At)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, due)
+	}
+
+	return occurrences, nil
+}
+
+// recurringTask returns the occurrence carrying the recurrence rule of the task series.
+func (s *TaskService) recurringTask(
+	ctx context.Context, task repository.TaskOutput, userID string,
+) (repository.TaskOutput, error) {
+	if task.RecurrenceRule != nil {
+		return task, nil
+	}
+	if task.SeriesID == nil {
+		return repository.TaskOutput{}, customErrors.ErrTaskNotRecurring
+	}
+
+	recurringTask, err := s.repo.GetRecurringBySeriesID(ctx, *task.SeriesID, userID)
+	if err != nil {
+		if errors.Is(err, customErrors.ErrTaskNotFound) {
+			return repository.TaskOutput{}, customErrors.ErrTaskNotRecurring
+		}
+		return repository.TaskOutput{}, err
+	}
+
+	return recurringTask, nil
+}
+
+// nextOccurrence builds the occurrence following the task completed at completedAt,
+// the task is taken with the changes of the update input that completes it.
+func nextOccurrence(
+	task repository.TaskOutput, inp repository.UpdateTaskInput, completedAt time.Time,
+) (domain.Task, error) {
+	recurrence, err := domain.ParseRecurrence(*task.RecurrenceRule)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	due := taskDue(task)
+	if inp.Due != nil {
+		due = *inp.Due
+	}
+	next, err := nextTaskDue(due, recurrence, completedAt)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	occurrence := domain.Task{
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		UserID:          inp.UserID,
+		CategoryID:      task.Category.ID,
+		Title:           task.Title,
+		Description:     task.Description,
+		Priority:        task.Priority,
+		DueDate:         next.Date,
+		DueTime:         next.Time,
+		DueTimezone:     next.Timezone,
+		DueAt:           next.At,
+		EstimateMinutes: task.EstimateMinutes,
+		RecurrenceRule:  task.RecurrenceRule,
+		SeriesID:        task.SeriesID,
+	}
+	if inp.CategoryID != nil {
+		occurrence.CategoryID = *inp.CategoryID
+	}
+	if inp.Title != nil {
+		occurrence.Title = *inp.Title
+	}
+	if inp.Description != nil {
+		occurrence.Description = *inp.Description
+	}
+	if inp.Priority != nil {
+		occurrence.Priority = *inp.Priority
+	}
+	if inp.EstimateMinutes != nil {
+		occurrence.EstimateMinutes = nil
+		if *inp.EstimateMinutes > 0 {
+			occurrence.EstimateMinutes = inp.EstimateMinutes
+		}
+	}
+
+	return occurrence, nil
+}
+
+// nextTaskDue shifts the due date to the next date of the recurrence keeping the due time and time zone.
+// Recurrence after completion counts days from completedAt in the due time zone.
+func nextTaskDue(due domain.TaskDue, recurrence domain.Recurrence, completedAt time.Time) (domain.TaskDue, error) {
+	var clock, timezone string
+	if due.Time != nil {
+		clock = *due.Time
+	}
+	if due.Timezone != nil {
+		timezone = *due.Timezone
+	}
+
+	after := *due.Date
+	if recurrence.Frequency == domain.RecurrenceAfterCompletion {
+		location := time.UTC
+		if timezone != "" {
+			var err error
+			location, err = time.LoadLocation(timezone)
+			if err != nil {
+				return domain.TaskDue{}, err
+			}
+		}
+		after = completedAt.In(location)
+	}
+
+	return domain.NewTaskDue(recurrence.Next(after).Format(time.DateOnly), clock, timezone)
+}
+
+func taskDue(task repository.TaskOutput) domain.TaskDue {
+	return domain.TaskDue{Date: task.DueDate, Time: task.DueTime, Timezone: task.DueTimezone, At: task.DueAt}
+}
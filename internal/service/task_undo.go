@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func (s *TaskService) Undo(ctx context.Context, token, userID string) ([]TaskOutput, error) {
+	// tokens are operation ids
+	if uuid.Validate(token) != nil {
+		return nil, customErrors.ErrUndoTokenNotFound
+	}
+
+	var taskIDs []string
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		operation, err := s.undoRepo.Redeem(ctx, token, userID, time.Now())
+		if err != nil {
+			return err
+		}
+
+		taskIDs = operation.Tasks.TaskIDs()
+		activities := make([]domain.Activity, len(taskIDs))
+		for i, taskID := range taskIDs {
+			activities[i] = newTaskActivity(taskID, userID, domain.ActivityUndone, nil)
+		}
+		return s.activityRepo.Create(ctx, activities...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.repo.GetListByIDs(ctx, taskIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadRelations(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	tasksOutput := make([]TaskOutput, len(tasks))
+	for i, task := range tasks {
+		tasksOutput[i] = TaskOutput(task)
+	}
+	return tasksOutput, nil
+}
+
+// newUndoToken stores the snapshots of the tasks changed by the operation, no token is created without snapshots.
+func (s *TaskService) newUndoToken(
+	ctx context.Context, userID, action string, snapshots []domain.TaskSnapshot,
+) (UndoToken, error) {
+	if len(snapshots) == 0 {
+		return UndoToken{}, nil
+	}
+
+	now := time.Now()
+	operation := domain.UndoOperation{
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.undoWindow),
+		UserID:    userID,
+		Action:    action,
+		Tasks:     snapshots,
+	}
+	token, err := s.undoRepo.Create(ctx, operation)
+	if err != nil {
+		return UndoToken{}, err
+	}
+
+	return UndoToken{Token: token, ExpiresAt: operation.ExpiresAt}, nil
+}
+
+// taskSnapshot keeps the task state before the operation, after is the task version the operation leaves.
+func taskSnapshot(task repository.TaskOutput, after domain.TaskVersion) domain.TaskSnapshot {
+	return domain.TaskSnapshot{
+		After:           after,
+		CategoryID:      task.Category.ID,
+		Title:           task.Title,
+		Description:     task.Description,
+		Status:          task.Status,
+		Priority:        task.Priority,
+		DueDate:         task.DueDate,
+		DueTime:         task.DueTime,
+		DueTimezone:     task.DueTimezone,
+		DueAt:           task.DueAt,
+		EstimateMinutes: task.EstimateMinutes,
+		RecurrenceRule:  task.RecurrenceRule,
+		Position:        task.Position,
+		DeletedAt:       task.DeletedAt,
+	}
+}
+
+// taskTagIDs never returns nil, so tasks without tags get their tags removed on undo.
+func taskTagIDs(tags []domain.Tag) []string {
+	ids := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID
+	}
+	return ids
+}
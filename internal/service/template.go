@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+	"unicode/utf8"
+)
+
+// templateTextMaxLength is the limit of substituted titles and checklist items in characters, the same as for tasks.
+const templateTextMaxLength = 255
+
+type TemplateService struct {
+	repo         repository.TemplateRepository
+	categoryRepo repository.CategoryRepository
+	tasksService Task
+}
+
+func NewTemplateService(
+	repo repository.TemplateRepository, categoryRepo repository.CategoryRepository, tasksService Task,
+) *TemplateService {
+	return &TemplateService{repo: repo, categoryRepo: categoryRepo, tasksService: tasksService}
+}
+
+func (s *TemplateService) Create(ctx context.Context, inp CreateTemplateInput) (domain.TaskTemplate, error) {
+	if err := s.checkTasks(ctx, inp.Tasks, inp.UserID); err != nil {
+		return domain.TaskTemplate{}, err
+	}
+
+	template := domain.TaskTemplate{
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		UserID:      inp.UserID,
+		Title:       inp.Title,
+		Description: inp.Description,
+		Tasks:       inp.Tasks,
+	}
+
+	return s.repo.Create(ctx, template)
+}
+
+func (s *TemplateService) Update(ctx context.Context, inp UpdateTemplateInput) (domain.TaskTemplate, error) {
+	_, err := s.repo.GetByID(ctx, inp.ID, inp.UserID)
+	if err != nil {
+		return domain.TaskTemplate{}, err
+	}
+
+	if inp.Title == nil && inp.Description == nil && inp.Tasks == nil {
+		return domain.TaskTemplate{}, customErrors.ErrNoUpdateFields
+	}
+
+	updateInput := repository.UpdateTemplateInput{
+		ID:          inp.ID,
+		UpdatedAt:   time.Now(),
+		Title:       inp.Title,
+		Description: inp.Description,
+	}
+	if inp.Tasks != nil {
+		if err := s.checkTasks(ctx, inp.Tasks, inp.UserID); err != nil {
+			return domain.TaskTemplate{}, err
+		}
+		tasks := domain.TemplateTasks(inp.Tasks)
+		updateInput.Tasks = &tasks
+	}
+
+	return s.repo.Update(ctx, updateInput)
+}
+
+func (s *TemplateService) Delete(ctx context.Context, templateID, userID string) error {
+	_, err := s.repo.GetByID(ctx, templateID, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, templateID)
+}
+
+func (s *TemplateService) GetByID(ctx context.Context, templateID, userID string) (domain.TaskTemplate, error) {
+	return s.repo.GetByID(ctx, templateID, userID)
+}
+
+func (s *TemplateService) GetList(ctx context.Context, userID string) ([]domain.TaskTemplate, error) {
+	return s.repo.GetListByUserID(ctx, userID)
+}
+
+func (s *TemplateService) Instantiate(ctx context.Context, inp InstantiateTemplateInput) ([]TaskOutput, error) {
+	template, err := s.repo.GetByID(ctx, inp.ID, inp.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate := time.Now().UTC()
+	if inp.StartDate != "" {
+		startDate, err = time.Parse(time.DateOnly, inp.StartDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	substitution := templateSubstitution{values: inp.Variables}
+	createInputs := make([]CreateTaskInput, len(template.Tasks))
+	for i, task := range template.Tasks {
+		createInputs[i] = CreateTaskInput{
+			UserID:          inp.UserID,
+			CategoryID:      task.CategoryID,
+			Title:           substitution.apply(task.Title),
+			Description:     task.Description,
+			Priority:        task.Priority,
+			DueTime:         task.DueTime,
+			DueTimezone:     task.DueTimezone,
+			EstimateMinutes: task.EstimateMinutes,
+			ChecklistItems:  make([]string, len(task.ChecklistItems)),
+		}
+		if task.DueOffsetDays != nil {
+			createInputs[i].DueDate = startDate.AddDate(0, 0, *task.DueOffsetDays).Format(time.DateOnly)
+		}
+		for j, item := range task.ChecklistItems {
+			createInputs[i].ChecklistItems[j] = substitution.apply(item)
+		}
+	}
+	if len(substitution.missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", customErrors.ErrTemplateVariableMissing, strings.Join(substitution.missing, ", "))
+	}
+	if err := checkSubstitutedTasks(createInputs); err != nil {
+		return nil, err
+	}
+
+	return s.tasksService.CreateList(ctx, createInputs)
+}
+
+// checkTasks makes sure the categories of the template tasks exist,
+// due time and timezone are allowed only for tasks with a due offset.
+func (s *TemplateService) checkTasks(ctx context.Context, tasks []domain.TemplateTask, userID string) error {
+	for _, task := range tasks {
+		if task.DueOffsetDays == nil && (task.DueTime != "" || task.DueTimezone != "") {
+			return customErrors.ErrDueDateRequired
+		}
+	}
+
+	for _, categoryID := range domain.TemplateTasks(tasks).CategoryIDs() {
+		if _, err := s.categoryRepo.GetByID(ctx, categoryID, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSubstitutedTasks applies the task title and checklist item rules to the texts with the variables substituted,
+// variable values can make them empty or too long.
+func checkSubstitutedTasks(inps []CreateTaskInput) error {
+	for i, inp := range inps {
+		if !validTemplateText(inp.Title) {
+			return fmt.Errorf(
+				"%w: title of task %d must be 1 to %d characters", customErrors.ErrInvalidTemplateTask, i+1, templateTextMaxLength,
+			)
+		}
+		for j, item := range inp.ChecklistItems {
+			if !validTemplateText(item) {
+				return fmt.Errorf(
+					"%w: checklist item %d of task %d must be 1 to %d characters",
+					customErrors.ErrInvalidTemplateTask, j+1, i+1, templateTextMaxLength,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validTemplateText(text string) bool {
+	length := utf8.RuneCountInString(text)
+	return length > 0 && length <= templateTextMaxLength
+}
+
+// templateSubstitution collects names of the variables missing from values over all substituted titles.
+type templateSubstitution struct {
+	values  map[string]string
+	missing []string
+}
+
+func (t *templateSubstitution) apply(text string) string {
+	result, missing := domain.SubstituteTemplateVariables(text, t.values)
+	for _, name := range missing {
+		if !slices.Contains(t.missing, name) {
+			t.missing = append(t.missing, name)
+		}
+	}
+	return result
+}
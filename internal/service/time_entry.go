@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"time"
+	"todo_list_go/internal/domain"
+	"todo_list_go/internal/repository"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+type TimeEntryService struct {
+	repo     repository.TimeEntryRepository
+	taskRepo repository.TaskRepository
+}
+
+func NewTimeEntryService(repo repository.TimeEntryRepository, taskRepo repository.TaskRepository) *TimeEntryService {
+	return &TimeEntryService{repo: repo, taskRepo: taskRepo}
+}
+
+func (s *TimeEntryService) StartTimer(ctx context.Context, taskID, userID string) (domain.TimeEntry, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	// the repository rejects a second running timer of the user
+	return s.repo.Create(ctx, domain.TimeEntry{
+		CreatedAt: time.Now(),
+		UserID:    userID,
+		TaskID:    taskID,
+		StartedAt: time.Now(),
+	})
+}
+
+// StopTimer doesn't check the task itself, so a timer left running on a task moved to the trash can be stopped.
+func (s *TimeEntryService) StopTimer(ctx context.Context, taskID, userID string) (domain.TimeEntry, error) {
+	running, err := s.repo.GetRunningByUserID(ctx, userID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	if running.TaskID != taskID {
+		return domain.TimeEntry{}, customErrors.ErrTimerNotRunning
+	}
+
+	return s.repo.Stop(ctx, running.ID, time.Now())
+}
+
+func (s *TimeEntryService) GetRunningTimer(ctx context.Context, userID string) (domain.TimeEntry, error) {
+	return s.repo.GetRunningByUserID(ctx, userID)
+}
+
+func (s *TimeEntryService) Create(ctx context.Context, inp CreateTimeEntryInput) (domain.TimeEntry, error) {
+	_, err := s.taskRepo.GetByID(ctx, inp.TaskID, inp.UserID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	endedAt := inp.StartedAt.Add(time.Duration(inp.Minutes) * time.Minute)
+
+	return s.repo.Create(ctx, domain.TimeEntry{
+		CreatedAt: time.Now(),
+		UserID:    inp.UserID,
+		TaskID:    inp.TaskID,
+		StartedAt: inp.StartedAt,
+		EndedAt:   &endedAt,
+	})
+}
+
+func (s *TimeEntryService) Delete(ctx context.Context, entryID, taskID, userID string) error {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetByID(ctx, entryID, taskID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, entryID)
+}
+
+func (s *TimeEntryService) GetList(ctx context.Context, taskID, userID string) ([]domain.TimeEntry, error) {
+	_, err := s.taskRepo.GetByID(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListByTaskID(ctx, taskID)
+}
+
+func (s *TimeEntryService) GetReport(ctx context.Context, inp TimeReportInput) (domain.TimeReport, error) {
+	location := time.UTC
+	if inp.Timezone != "" {
+		var err error
+		location, err = time.LoadLocation(inp.Timezone)
+		if err != nil {
+			return domain.TimeReport{}, err
+		}
+	}
+
+	from, err := time.ParseInLocation(time.DateOnly, inp.DateFrom, location)
+	if err != nil {
+		return domain.TimeReport{}, err
+	}
+	to, err := time.ParseInLocation(time.DateOnly, inp.DateTo, location)
+	if err != nil {
+		return domain.TimeReport{}, err
+	}
+	if to.Before(from) {
+		return domain.TimeReport{}, customErrors.ErrInvalidDateRange
+	}
+
+	// the range includes both dates
+	return s.repo.GetReport(ctx, inp.UserID, from, to.AddDate(0, 0, 1), location.String())
+}
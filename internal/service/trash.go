@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"todo_list_go/internal/repository"
+	"todo_list_go/pkg/storage"
+)
+
+type TrashService struct {
+	taskRepo       repository.TaskRepository
+	categoryRepo   repository.CategoryRepository
+	attachmentRepo repository.AttachmentRepository
+	storage        storage.FileStorage
+}
+
+func NewTrashService(
+	taskRepo repository.TaskRepository, categoryRepo repository.CategoryRepository,
+	attachmentRepo repository.AttachmentRepository, fileStorage storage.FileStorage,
+) *TrashService {
+	return &TrashService{
+		taskRepo:       taskRepo,
+		categoryRepo:   categoryRepo,
+		attachmentRepo: attachmentRepo,
+		storage:        fileStorage,
+	}
+}
+
+func (s *TrashService) Purge(ctx context.Context, retention time.Duration) (TrashPurgeResult, error) {
+	deletedBefore := time.Now().Add(-retention)
+
+	// attachment metadata is removed together with the tasks, so their files are looked up beforehand
+	storageKeys, err := s.attachmentRepo.GetStorageKeysOfDeletedTasks(ctx, deletedBefore)
+	if err != nil {
+		return TrashPurgeResult{}, err
+	}
+
+	// tasks go first, so tasks of purged categories aren't left without a category
+	tasks, err := s.taskRepo.Purge(ctx, deletedBefore)
+	if err != nil {
+		return TrashPurgeResult{}, err
+	}
+
+	// a file that fails to be removed doesn't stop the others
+	var storageErrs []error
+	for _, key := range storageKeys {
+		err := s.storage.Delete(ctx, key)
+		if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+			storageErrs = append(storageErrs, err)
+		}
+	}
+
+	categories, err := s.categoryRepo.Purge(ctx, deletedBefore)
+	if err != nil {
+		return TrashPurgeResult{Tasks: tasks}, err
+	}
+
+	return TrashPurgeResult{Tasks: tasks, Categories: categories}, errors.Join(storageErrs...)
+}
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_tasks_user_due_at;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS due_date,
+    DROP COLUMN IF EXISTS due_time,
+    DROP COLUMN IF EXISTS due_timezone,
+    DROP COLUMN IF EXISTS due_at;
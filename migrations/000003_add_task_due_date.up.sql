@@ -0,0 +1,7 @@
+ALTER TABLE tasks
+    ADD COLUMN due_date DATE,
+    ADD COLUMN due_time TIME,
+    ADD COLUMN due_timezone VARCHAR(64),
+    ADD COLUMN due_at TIMESTAMPTZ;
+
+CREATE INDEX idx_tasks_user_due_at ON tasks (user_id, due_at);
@@ -0,0 +1,5 @@
+ALTER TABLE tasks
+    ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT check_tasks_priority CHECK (priority BETWEEN 0 AND 4);
+
+CREATE INDEX idx_tasks_user_priority ON tasks (user_id, priority);
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS idx_tasks_series_id;
+DROP INDEX IF EXISTS unique_user_task_title;
+
+-- occurrences of a recurring task share its title, all but the latest one get a suffix to be unique again
+UPDATE tasks t
+SET title = left(t.title, 244) || ' (' || left(t.id::text, 8) || ')'
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY user_id, title ORDER BY created_at DESC) AS n
+    FROM tasks
+) d
+WHERE t.id = d.id AND d.n > 1;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS recurrence_rule,
+    DROP COLUMN IF EXISTS series_id;
+
+ALTER TABLE tasks ADD CONSTRAINT unique_user_task_title UNIQUE (user_id, title);
@@ -0,0 +1,9 @@
+ALTER TABLE tasks
+    ADD COLUMN recurrence_rule VARCHAR(255),
+    ADD COLUMN series_id UUID;
+
+-- occurrences of a recurring task share its title
+ALTER TABLE tasks DROP CONSTRAINT unique_user_task_title;
+CREATE UNIQUE INDEX unique_user_task_title ON tasks (user_id, title) WHERE series_id IS NULL;
+
+CREATE INDEX idx_tasks_series_id ON tasks (series_id);
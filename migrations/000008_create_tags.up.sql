@@ -0,0 +1,19 @@
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    user_id UUID NOT NULL,
+    title VARCHAR(64) NOT NULL,
+    color VARCHAR(255),
+    CONSTRAINT fk_tags_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT unique_user_tag_title UNIQUE (user_id, title)
+);
+
+CREATE TABLE task_tags (
+    task_id UUID NOT NULL,
+    tag_id UUID NOT NULL,
+    PRIMARY KEY (task_id, tag_id),
+    CONSTRAINT fk_tasktags_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT fk_tasktags_tag FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_tags_tag_id ON task_tags (tag_id);
@@ -0,0 +1,14 @@
+DELETE FROM tasks WHERE deleted_at IS NOT NULL;
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_tasks_deleted_at;
+DROP INDEX IF EXISTS idx_categories_deleted_at;
+
+DROP INDEX IF EXISTS unique_user_category_title;
+ALTER TABLE categories ADD CONSTRAINT unique_user_category_title UNIQUE (user_id, title);
+
+DROP INDEX IF EXISTS unique_user_task_title;
+CREATE UNIQUE INDEX unique_user_task_title ON tasks (user_id, title) WHERE series_id IS NULL;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE categories DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,12 @@
+ALTER TABLE tasks ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE categories ADD COLUMN deleted_at TIMESTAMP;
+
+-- deleted tasks and categories don't hold their titles
+DROP INDEX unique_user_task_title;
+CREATE UNIQUE INDEX unique_user_task_title ON tasks (user_id, title) WHERE series_id IS NULL AND deleted_at IS NULL;
+
+ALTER TABLE categories DROP CONSTRAINT unique_user_category_title;
+CREATE UNIQUE INDEX unique_user_category_title ON categories (user_id, title) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_tasks_deleted_at ON tasks (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_categories_deleted_at ON categories (deleted_at) WHERE deleted_at IS NOT NULL;
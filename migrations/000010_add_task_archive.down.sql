@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_user_id_not_archived;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,4 @@
+ALTER TABLE tasks ADD COLUMN archived_at TIMESTAMP;
+
+-- the task list skips archived tasks unless they are requested
+CREATE INDEX idx_tasks_user_id_not_archived ON tasks (user_id) WHERE archived_at IS NULL;
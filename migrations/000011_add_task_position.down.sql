@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_category_id_position;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS position;
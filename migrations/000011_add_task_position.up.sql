@@ -0,0 +1,16 @@
+-- position is a fractional index key ordering tasks within a category,
+-- keys are compared byte by byte, so the column uses the "C" collation
+ALTER TABLE tasks ADD COLUMN position VARCHAR(255) COLLATE "C";
+
+-- existing tasks are ordered by creation time, keys must not end with the smallest digit
+UPDATE tasks t
+SET position = p.position
+FROM (
+    SELECT id, lpad(row_number() OVER (PARTITION BY category_id ORDER BY created_at, id)::text, 10, '0') || 'V' AS position
+    FROM tasks
+) p
+WHERE t.id = p.id;
+
+ALTER TABLE tasks ALTER COLUMN position SET NOT NULL;
+
+CREATE INDEX idx_tasks_category_id_position ON tasks (category_id, position);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_tasks_user_status;
+
+ALTER TABLE tasks ADD COLUMN completed_value BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE tasks SET completed_value = completed;
+ALTER TABLE tasks DROP COLUMN completed;
+ALTER TABLE tasks RENAME COLUMN completed_value TO completed;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS check_tasks_status,
+    DROP COLUMN IF EXISTS status;
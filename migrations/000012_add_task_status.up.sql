@@ -0,0 +1,11 @@
+ALTER TABLE tasks
+    ADD COLUMN status SMALLINT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT check_tasks_status CHECK (status BETWEEN 0 AND 3);
+
+UPDATE tasks SET status = 3 WHERE completed;
+
+-- completed is derived from the status, 3 is done
+ALTER TABLE tasks DROP COLUMN completed;
+ALTER TABLE tasks ADD COLUMN completed BOOLEAN NOT NULL GENERATED ALWAYS AS (status = 3) STORED;
+
+CREATE INDEX idx_tasks_user_status ON tasks (user_id, status);
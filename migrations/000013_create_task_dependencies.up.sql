@@ -0,0 +1,12 @@
+-- a task can't be completed while the tasks blocking it are open
+CREATE TABLE task_dependencies (
+    task_id UUID NOT NULL,
+    blocker_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (task_id, blocker_id),
+    CONSTRAINT fk_task_dependencies_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_dependencies_blocker FOREIGN KEY (blocker_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT check_task_dependencies_self CHECK (task_id <> blocker_id)
+);
+
+CREATE INDEX idx_task_dependencies_blocker_id ON task_dependencies (blocker_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS time_entries;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS check_tasks_estimate_minutes,
+    DROP COLUMN IF EXISTS estimate_minutes;
@@ -0,0 +1,21 @@
+ALTER TABLE tasks
+    ADD COLUMN estimate_minutes INTEGER,
+    ADD CONSTRAINT check_tasks_estimate_minutes CHECK (estimate_minutes > 0);
+
+-- a running timer is an entry without ended_at
+CREATE TABLE time_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    user_id UUID NOT NULL,
+    task_id UUID NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    CONSTRAINT fk_time_entries_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_time_entries_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT check_time_entries_range CHECK (ended_at >= started_at)
+);
+
+CREATE INDEX idx_time_entries_task_id ON time_entries (task_id);
+CREATE INDEX idx_time_entries_user_started_at ON time_entries (user_id, started_at);
+-- at most one running timer per user
+CREATE UNIQUE INDEX idx_time_entries_user_running ON time_entries (user_id) WHERE ended_at IS NULL;
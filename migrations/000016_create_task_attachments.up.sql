@@ -0,0 +1,16 @@
+CREATE TABLE task_attachments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    task_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    CONSTRAINT fk_task_attachments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_attachments_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT check_task_attachments_size CHECK (size >= 0)
+);
+
+CREATE INDEX idx_task_attachments_task_id ON task_attachments (task_id);
+CREATE INDEX idx_task_attachments_user_id ON task_attachments (user_id);
@@ -0,0 +1,13 @@
+CREATE TABLE task_activities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    user_id UUID NOT NULL,
+    task_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    CONSTRAINT fk_task_activities_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_activities_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_activities_task_created_at ON task_activities (task_id, created_at);
+CREATE INDEX idx_task_activities_user_created_at ON task_activities (user_id, created_at, id);
@@ -0,0 +1,11 @@
+CREATE TABLE undo_operations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP NOT NULL,
+    user_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    tasks JSONB NOT NULL,
+    CONSTRAINT fk_undo_operations_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_undo_operations_user_expires_at ON undo_operations (user_id, expires_at);
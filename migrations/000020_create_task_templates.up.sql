@@ -0,0 +1,11 @@
+CREATE TABLE task_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    user_id UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    tasks JSONB NOT NULL DEFAULT '[]',
+    CONSTRAINT fk_task_templates_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT unique_user_task_template_title UNIQUE (user_id, title)
+);
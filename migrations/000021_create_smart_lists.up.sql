@@ -0,0 +1,10 @@
+CREATE TABLE smart_lists (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    user_id UUID NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    query JSONB NOT NULL DEFAULT '{}',
+    CONSTRAINT fk_smart_lists_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT unique_user_smart_list_title UNIQUE (user_id, title)
+);
@@ -0,0 +1,14 @@
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS unique_category_task_position;
+
+-- keys may have grown over the former limit
+UPDATE tasks t
+SET position = p.position
+FROM (
+    SELECT id, lpad(row_number() OVER (PARTITION BY category_id ORDER BY position, created_at, id)::text, 10, '0') || 'V' AS position
+    FROM tasks
+) p
+WHERE t.id = p.id;
+
+ALTER TABLE tasks ALTER COLUMN position TYPE VARCHAR(255) COLLATE "C";
+
+CREATE INDEX idx_tasks_category_id_position ON tasks (category_id, position);
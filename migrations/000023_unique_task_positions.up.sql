@@ -0,0 +1,18 @@
+-- keys of tasks moved again and again between the same neighbours grow, so the length is not limited
+ALTER TABLE tasks ALTER COLUMN position TYPE TEXT COLLATE "C";
+
+-- keys are rewritten with the integer part the end of a category is counted from,
+-- this also separates keys given out twice to concurrently created tasks
+UPDATE tasks t
+SET position = p.position
+FROM (
+    SELECT id, lpad(row_number() OVER (PARTITION BY category_id ORDER BY position, created_at, id)::text, 10, '0') || 'V' AS position
+    FROM tasks
+) p
+WHERE t.id = p.id;
+
+DROP INDEX IF EXISTS idx_tasks_category_id_position;
+
+-- deferrable, so uniqueness is checked at the end of a statement rewriting positions of a category
+ALTER TABLE tasks ADD CONSTRAINT unique_category_task_position
+    UNIQUE (category_id, position) DEFERRABLE INITIALLY IMMEDIATE;
@@ -10,15 +10,62 @@ import (
 )
 
 var (
-	ErrUserNotFound          = errors.New("user doesn't exists")
-	ErrTaskNotFound          = errors.New("task not found")
-	ErrCategoryNotFound      = errors.New("category not found")
-	ErrUserAlreadyExists     = errors.New("user with such email already exists")
-	ErrTaskAlreadyExists     = errors.New("task with such title already exists")
-	ErrCategoryAlreadyExists = errors.New("category with such title already exists")
-	ErrNoUpdateFields        = errors.New("no fields specified for update")
+	ErrUserNotFound                = errors.New("user doesn't exists")
+	ErrTaskNotFound                = errors.New("task not found")
+	ErrCategoryNotFound            = errors.New("category not found")
+	ErrTagNotFound                 = errors.New("tag not found")
+	ErrChecklistItemNotFound       = errors.New("checklist item not found")
+	ErrUserAlreadyExists           = errors.New("user with such email already exists")
+	ErrTaskAlreadyExists           = errors.New("task with such title already exists")
+	ErrCategoryAlreadyExists       = errors.New("category with such title already exists")
+	ErrTagAlreadyExists            = errors.New("tag with such title already exists")
+	ErrNoUpdateFields              = errors.New("no fields specified for update")
+	ErrDueDateRequired             = errors.New("due date is required when due time or timezone is set")
+	ErrInvalidCursor               = errors.New("invalid cursor")
+	ErrInvalidChecklistOrder       = errors.New("checklist order must contain every item of the task exactly once")
+	ErrRecurrenceDueDate           = errors.New("recurring task must have a due date")
+	ErrTaskNotRecurring            = errors.New("task is not recurring")
+	ErrTaskCategoryDeleted         = errors.New("task category is in the trash, restore the category first")
+	ErrTooManyBulkTasks            = errors.New("too many tasks for a bulk operation")
+	ErrTaskNeighbour               = errors.New("task neighbours must be other tasks of the same category")
+	ErrInvalidTaskMove             = errors.New("the after task must be placed before the before task")
+	ErrTaskStatusTransition        = errors.New("task status transition is not allowed")
+	ErrTaskDependencyNotFound      = errors.New("task dependency not found")
+	ErrTaskDependencyAlreadyExists = errors.New("task is already blocked by this task")
+	ErrTaskDependencyCycle         = errors.New("task dependency would create a cycle")
+	ErrBlockerNotFound             = errors.New("blocking task not found")
+	ErrTaskBlocked                 = errors.New("task is blocked by open tasks")
+	ErrTimeEntryNotFound           = errors.New("time entry not found")
+	ErrTimerAlreadyRunning         = errors.New("another timer is already running, stop it first")
+	ErrTimerNotRunning             = errors.New("timer of the task is not running")
+	ErrInvalidDateRange            = errors.New("date range end must not be before its start")
+	ErrCommentNotFound             = errors.New("comment not found")
+	ErrNotCommentAuthor            = errors.New("only the author can change the comment")
+	ErrAttachmentNotFound          = errors.New("attachment not found")
+	ErrAttachmentTooLarge          = errors.New("file exceeds the maximum attachment size")
+	ErrAttachmentContentType       = errors.New("file type is not allowed for attachments")
+	ErrStorageQuotaExceeded        = errors.New("attachment storage quota exceeded")
+	ErrTaskDescriptionTooLong      = errors.New("task description exceeds the maximum length")
+	ErrUndoTokenNotFound           = errors.New("undo token not found or expired")
+	ErrUndoConflict                = errors.New("tasks have changed since the operation, it can't be undone")
+	ErrTemplateNotFound            = errors.New("template not found")
+	ErrTemplateAlreadyExists       = errors.New("template with such title already exists")
+	ErrTemplateVariableMissing     = errors.New("template variable is missing")
+	ErrInvalidTemplateTask         = errors.New("invalid template task after variable substitution")
+	ErrQuickAddTitleRequired       = errors.New("task title is missing from the text")
+	ErrQuickAddCategoryRequired    = errors.New("category is required, add #category to the text or pass category_id")
+	ErrSmartListNotFound           = errors.New("smart list not found")
+	ErrSmartListAlreadyExists      = errors.New("smart list with such title already exists")
+	ErrInvalidSmartListQuery       = errors.New("invalid smart list query")
+	ErrInvalidTaskFilter           = errors.New("invalid task filter")
 )
 
+// dateTimeFormats maps Go layouts used in "datetime" validation tags to human-readable formats.
+var dateTimeFormats = map[string]string{
+	"2006-01-02": "yyyy-mm-dd",
+	"15:04":      "hh:mm",
+}
+
 func IsDuplicateDBError(err error) bool {
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
@@ -37,12 +84,8 @@ func FormatValidationErrorOutput(err error, inputObj any) map[string]string {
 		}
 
 		for _, fe := range ve {
-			if field, ok := t.FieldByName(fe.StructField()); ok {
-				jsonTag := strings.Split(field.Tag.Get("json"), ",")[0]
-				if jsonTag == "-" || jsonTag == "" {
-					jsonTag = strings.ToLower(fe.Field())
-				}
-				out[jsonTag] = ValidationErrorToText(fe)
+			if fieldName, ok := validationErrorFieldName(t, fe); ok {
+				out[fieldName] = ValidationErrorToText(fe)
 			}
 		}
 		return out
@@ -50,14 +93,56 @@ func FormatValidationErrorOutput(err error, inputObj any) map[string]string {
 	return nil
 }
 
+// validationErrorFieldName returns the name of the invalid field as it is passed in requests,
+// fields of nested structs are joined with dots, e.g. "recurrence.weekdays".
+func validationErrorFieldName(t reflect.Type, fe validator.FieldError) (string, bool) {
+	// the namespace starts with the name of the validated struct
+	_, namespace, _ := strings.Cut(fe.StructNamespace(), ".")
+
+	names := make([]string, 0, 1)
+	for _, structField := range strings.Split(namespace, ".") {
+		// errors of slice items are reported as "Field[i]"
+		structField, _, _ = strings.Cut(structField, "[")
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return "", false
+		}
+
+		field, ok := t.FieldByName(structField)
+		if !ok {
+			return "", false
+		}
+		t = field.Type
+		if field.Anonymous {
+			// fields of embedded structs are promoted
+			continue
+		}
+
+		fieldName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if fieldName == "-" || fieldName == "" {
+			fieldName = strings.Split(field.Tag.Get("form"), ",")[0]
+		}
+		if fieldName == "-" || fieldName == "" {
+			fieldName = strings.ToLower(structField)
+		}
+		names = append(names, fieldName)
+	}
+
+	return strings.Join(names, "."), true
+}
+
 func ValidationErrorToText(fe validator.FieldError) string {
 	fieldKind := fe.Kind()
 
 	switch fe.Tag() {
 	case "uuid":
 		return "must be a valid UUID"
-	case "required":
+	case "required", "required_if", "required_without":
 		return "is required"
+	case "excluded_unless", "excluded_with":
+		return "is not allowed"
 	case "email":
 		return "must be a valid email address"
 	case "min":
@@ -70,8 +155,26 @@ func ValidationErrorToText(fe validator.FieldError) string {
 			return fmt.Sprintf("must be at most %s characters", fe.Param())
 		}
 		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "excludes":
+		return fmt.Sprintf("must not contain %q", fe.Param())
 	case "oneof":
 		return fmt.Sprintf("must be one of: %s", fe.Param())
+	case "csv_uuid":
+		return "must be a comma-separated list of UUIDs"
+	case "csv_oneof":
+		return fmt.Sprintf("must be a comma-separated list of: %s", fe.Param())
+	case "sort_fields":
+		return fmt.Sprintf(
+			"must be a comma-separated list of unique fields: %s, prefix a field with - for descending order",
+			fe.Param(),
+		)
+	case "datetime":
+		if format, ok := dateTimeFormats[fe.Param()]; ok {
+			return fmt.Sprintf("must match the format %s", format)
+		}
+		return "is not a valid date or time"
+	case "timezone":
+		return "must be a valid IANA time zone"
 	default:
 		return "is not valid"
 	}
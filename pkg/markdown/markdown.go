@@ -0,0 +1,48 @@
+package markdown
+
+import (
+	"bytes"
+	"github.com/russross/blackfriday/v2"
+	"io"
+	"strings"
+)
+
+// htmlFlags drop raw HTML and links to untrusted protocols, e.g. javascript:, links open in a new tab.
+const htmlFlags = blackfriday.CommonHTMLFlags | blackfriday.SkipHTML | blackfriday.Safelink |
+	blackfriday.NofollowLinks | blackfriday.NoreferrerLinks | blackfriday.NoopenerLinks | blackfriday.HrefTargetBlank
+
+// safeURLPrefixes are the image sources allowed in rendered HTML, they match the links allowed by Safelink.
+var safeURLPrefixes = []string{"http://", "https://", "/", "./", "../"}
+
+// ToHTML renders Markdown source to HTML that is safe to embed into a page:
+// raw HTML is skipped and links and images pointing to untrusted protocols are dropped.
+func ToHTML(source string) string {
+	renderer := safeRenderer{blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{Flags: htmlFlags})}
+
+	return string(bytes.TrimSpace(blackfriday.Run(
+		[]byte(source),
+		blackfriday.WithRenderer(renderer),
+		blackfriday.WithExtensions(blackfriday.CommonExtensions),
+	)))
+}
+
+type safeRenderer struct {
+	*blackfriday.HTMLRenderer
+}
+
+// RenderNode drops images with unsafe sources, Safelink covers links only.
+func (r safeRenderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	if node.Type == blackfriday.Image && !isSafeURL(string(node.LinkData.Destination)) {
+		return blackfriday.SkipChildren
+	}
+	return r.HTMLRenderer.RenderNode(w, node, entering)
+}
+
+func isSafeURL(url string) bool {
+	for _, prefix := range safeURLPrefixes {
+		if len(url) > len(prefix) && strings.EqualFold(url[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+//go:generate mockgen -source=storage.go -destination=mocks/mock_storage.go
+
+var ErrObjectNotFound = errors.New("stored file not found")
+
+// FileStorage keeps file contents by key, keys are slash-separated paths.
+type FileStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get returns the file contents, the caller must close them.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"todo_list_go/internal/domain"
+)
+
+func TestPositionBetween(t *testing.T) {
+	testTable := []struct {
+		name          string
+		prev          string
+		next          string
+		expected      string
+		expectedError error
+	}{
+		{name: "Empty list", expected: "0000000001V"},
+		{name: "End of list", prev: "0000000001V", expected: "0000000002V"},
+		{name: "End of list after a moved task", prev: "0000000002", expected: "0000000003V"},
+		{name: "End of list carry", prev: "000000000zV", expected: "0000000010V"},
+		{name: "End of list after a short key", prev: "V", expected: "V000000001V"},
+		{name: "Start of list", next: "V", expected: "F"},
+		{name: "Between", prev: "F", next: "V", expected: "N"},
+		{name: "Consecutive digits", prev: "F", next: "G", expected: "FV"},
+		{name: "Longer next", prev: "F", next: "GV", expected: "G"},
+		{name: "Common prefix", prev: "0000000001V", next: "0000000002V", expected: "0000000002"},
+		{name: "Before smallest key", next: "01", expected: "00V"},
+		{name: "Exhausted integer part", prev: "zzzzzzzzzz", expected: "zzzzzzzzzzV"},
+		{name: "Equal keys", prev: "V", next: "V", expectedError: domain.ErrInvalidPositionRange},
+		{name: "Wrong order", prev: "k", next: "V", expectedError: domain.ErrInvalidPositionRange},
+		{name: "Trailing smallest digit", prev: "V0", expectedError: domain.ErrInvalidPositionRange},
+		{name: "Invalid digit", prev: "V-", expectedError: domain.ErrInvalidPositionRange},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			position, err := domain.PositionBetween(testCase.prev, testCase.next)
+
+			assert.Equal(t, testCase.expectedError, err)
+			assert.Equal(t, testCase.expected, position)
+			if err == nil {
+				assert.Less(t, testCase.prev, position)
+				if testCase.next != "" {
+					assert.Less(t, position, testCase.next)
+				}
+			}
+		})
+	}
+}
+
+func TestPositionBetweenRepeatedAppends(t *testing.T) {
+	// keys appended to the end keep their length
+	last := ""
+	for i := 0; i < 5000; i++ {
+		position, err := domain.PositionBetween(last, "")
+		assert.NoError(t, err)
+		assert.Less(t, last, position)
+		assert.Len(t, position, 11)
+		last = position
+	}
+	assert.False(t, domain.PositionNeedsRebalance(last))
+}
+
+func TestPositionBetweenRepeatedInserts(t *testing.T) {
+	// inserting at the same place keeps the keys ordered
+	prev, next := "V", "W"
+	for i := 0; i < 200; i++ {
+		position, err := domain.PositionBetween(prev, next)
+		assert.NoError(t, err)
+		assert.Less(t, prev, position)
+		assert.Less(t, position, next)
+		next = position
+	}
+	assert.True(t, domain.PositionNeedsRebalance(next))
+}
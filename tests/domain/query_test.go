@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"todo_list_go/internal/domain"
+)
+
+func TestGetTasksQuerySortFields(t *testing.T) {
+	testTable := []struct {
+		name     string
+		query    domain.GetTasksQuery
+		expected []domain.SortField
+	}{
+		{
+			name:     "Default",
+			expected: []domain.SortField{{Name: "created_at", Descending: true}},
+		},
+		{
+			name:     "Default with search",
+			query:    domain.GetTasksQuery{TaskFiltersQuery: domain.TaskFiltersQuery{Q: "report"}},
+			expected: []domain.SortField{{Name: "relevance", Descending: true}},
+		},
+		{
+			name:  "Priority",
+			query: domain.GetTasksQuery{Sort: "priority"},
+			expected: []domain.SortField{
+				{Name: "priority", Descending: true}, {Name: "due_date"}, {Name: "created_at", Descending: true},
+			},
+		},
+		{
+			name:  "Reversed priority",
+			query: domain.GetTasksQuery{Sort: "-priority"},
+			expected: []domain.SortField{
+				{Name: "priority"}, {Name: "due_date"}, {Name: "created_at", Descending: true},
+			},
+		},
+		{
+			name:  "Priority with explicit tie-breaker",
+			query: domain.GetTasksQuery{Sort: "title,priority,-due_date"},
+			expected: []domain.SortField{
+				{Name: "title"}, {Name: "priority", Descending: true}, {Name: "created_at", Descending: true},
+				{Name: "due_date", Descending: true},
+			},
+		},
+		{
+			name:     "Other fields",
+			query:    domain.GetTasksQuery{Sort: "-due_date,title"},
+			expected: []domain.SortField{{Name: "due_date", Descending: true}, {Name: "title"}},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.query.SortFields())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	// Wednesday
+	now := time.Date(2025, 1, 15, 22, 30, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name     string
+		text     string
+		now      time.Time
+		expected domain.QuickAddTask
+	}{
+		{
+			name: "All fields",
+			text: "Pay rent tomorrow 9am #Finance !high @bills",
+			expected: domain.QuickAddTask{
+				Title: "Pay rent", Category: "Finance", Priority: "high", DueDate: "2025-01-16", DueTime: "09:00",
+				Tags: []string{"bills"},
+			},
+		},
+		{name: "Title only", text: "Call mom", expected: domain.QuickAddTask{Title: "Call mom"}},
+		{name: "Today", text: "Review PR today", expected: domain.QuickAddTask{Title: "Review PR", DueDate: "2025-01-15"}},
+		{name: "Weekday after today", text: "Standup friday", expected: domain.QuickAddTask{Title: "Standup", DueDate: "2025-01-17"}},
+		{name: "Same weekday is next week", text: "Gym on Wed", expected: domain.QuickAddTask{Title: "Gym", DueDate: "2025-01-22"}},
+		{name: "Next week", text: "Plan sprint next week", expected: domain.QuickAddTask{Title: "Plan sprint", DueDate: "2025-01-20"}},
+		{name: "In days", text: "Renew passport in 10 days", expected: domain.QuickAddTask{Title: "Renew passport", DueDate: "2025-01-25"}},
+		{name: "In weeks", text: "Dentist in 2 weeks", expected: domain.QuickAddTask{Title: "Dentist", DueDate: "2025-01-29"}},
+		{name: "ISO date", text: "File taxes 2025-04-15", expected: domain.QuickAddTask{Title: "File taxes", DueDate: "2025-04-15"}},
+		{name: "Invalid ISO date", text: "File taxes 2025-02-30", expected: domain.QuickAddTask{Title: "File taxes 2025-02-30"}},
+		{
+			name:     "Time without date is due today",
+			text:     "Call Bob at 9:30pm",
+			expected: domain.QuickAddTask{Title: "Call Bob", DueDate: "2025-01-15", DueTime: "21:30"},
+		},
+		{name: "24-hour time", text: "Deploy 18:05 today", expected: domain.QuickAddTask{Title: "Deploy", DueDate: "2025-01-15", DueTime: "18:05"}},
+		{name: "Noon", text: "Lunch noon tomorrow", expected: domain.QuickAddTask{Title: "Lunch", DueDate: "2025-01-16", DueTime: "12:00"}},
+		{name: "12am", text: "Backup 12am tomorrow", expected: domain.QuickAddTask{Title: "Backup", DueDate: "2025-01-16", DueTime: "00:00"}},
+		{name: "Invalid time", text: "Meet 25:00", expected: domain.QuickAddTask{Title: "Meet 25:00"}},
+		{name: "Words that aren't markers", text: "Sit on the chair at home", expected: domain.QuickAddTask{Title: "Sit on the chair at home"}},
+		{name: "Unknown priority", text: "Fix bug !asap", expected: domain.QuickAddTask{Title: "Fix bug !asap"}},
+		{name: "Priority is case-insensitive", text: "Fix bug !URGENT", expected: domain.QuickAddTask{Title: "Fix bug", Priority: "urgent"}},
+		{
+			name:     "Repeated markers",
+			text:     "Read #Home #Books today tomorrow @a @b @A",
+			expected: domain.QuickAddTask{Title: "Read #Books tomorrow", Category: "Home", DueDate: "2025-01-15", Tags: []string{"a", "b"}},
+		},
+		{
+			name:     "Relative dates use the location of now",
+			text:     "Stretch tomorrow",
+			now:      now.In(time.FixedZone("UTC+3", 3*60*60)),
+			expected: domain.QuickAddTask{Title: "Stretch", DueDate: "2025-01-17"},
+		},
+		{name: "Markers only", text: "#Work tomorrow", expected: domain.QuickAddTask{Category: "Work", DueDate: "2025-01-16"}},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			clock := now
+			if !testCase.now.IsZero() {
+				clock = testCase.now
+			}
+			assert.Equal(t, testCase.expected, domain.ParseQuickAdd(testCase.text, clock))
+		})
+	}
+}
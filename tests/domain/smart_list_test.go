@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+)
+
+func TestDateRangeResolve(t *testing.T) {
+	// Wednesday
+	today := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name          string
+		dateRange     domain.DateRange
+		expectedFrom  string
+		expectedTo    string
+		expectedError bool
+	}{
+		{name: "Empty", dateRange: domain.DateRange{}},
+		{name: "Today", dateRange: domain.DateRange{Relative: "today"}, expectedFrom: "2025-03-12", expectedTo: "2025-03-12"},
+		{name: "Yesterday", dateRange: domain.DateRange{Relative: "Yesterday"}, expectedFrom: "2025-03-11", expectedTo: "2025-03-11"},
+		{name: "This week", dateRange: domain.DateRange{Relative: "this week"}, expectedFrom: "2025-03-10", expectedTo: "2025-03-16"},
+		{name: "Last week", dateRange: domain.DateRange{Relative: "last week"}, expectedFrom: "2025-03-03", expectedTo: "2025-03-09"},
+		{name: "Next week", dateRange: domain.DateRange{Relative: "next week"}, expectedFrom: "2025-03-17", expectedTo: "2025-03-23"},
+		{name: "This month", dateRange: domain.DateRange{Relative: "this month"}, expectedFrom: "2025-03-01", expectedTo: "2025-03-31"},
+		{name: "Last month", dateRange: domain.DateRange{Relative: "last month"}, expectedFrom: "2025-02-01", expectedTo: "2025-02-28"},
+		{name: "Last 7 days", dateRange: domain.DateRange{Relative: "last 7 days"}, expectedFrom: "2025-03-06", expectedTo: "2025-03-12"},
+		{name: "Next 3 days", dateRange: domain.DateRange{Relative: "next 3 days"}, expectedFrom: "2025-03-12", expectedTo: "2025-03-14"},
+		{name: "Bounds", dateRange: domain.DateRange{From: "2025-01-01", To: "tomorrow"}, expectedFrom: "2025-01-01", expectedTo: "2025-03-13"},
+		{name: "Open bound", dateRange: domain.DateRange{To: "today"}, expectedTo: "2025-03-12"},
+		{name: "Unknown relative", dateRange: domain.DateRange{Relative: "last 0 days"}, expectedError: true},
+		{name: "Relative with bounds", dateRange: domain.DateRange{Relative: "today", From: "2025-01-01"}, expectedError: true},
+		{name: "Invalid bound", dateRange: domain.DateRange{From: "01.01.2025"}, expectedError: true},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			from, to, err := testCase.dateRange.Resolve(today)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedFrom, from)
+			assert.Equal(t, testCase.expectedTo, to)
+		})
+	}
+}
+
+func TestSmartListQueryTasksQuery(t *testing.T) {
+	// late evening in UTC is already the next day in Tokyo
+	now := time.Date(2025, 3, 12, 22, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name          string
+		query         domain.SmartListQuery
+		expected      domain.GetTasksQuery
+		expectedError string
+	}{
+		{
+			name: "UTC",
+			query: domain.SmartListQuery{
+				Priorities: []string{"high"},
+				DueDate:    domain.DateRange{Relative: "today"},
+				Sort:       "due_date",
+			},
+			expected: domain.GetTasksQuery{
+				TaskFiltersQuery: domain.TaskFiltersQuery{
+					Priorities:  []string{"high"},
+					DueDateFrom: "2025-03-12",
+					DueDateTo:   "2025-03-12",
+				},
+				Sort: "due_date",
+			},
+		},
+		{
+			name: "Timezone",
+			query: domain.SmartListQuery{
+				CreatedAt: domain.DateRange{Relative: "last 2 days"},
+				UpdatedAt: domain.DateRange{From: "yesterday"},
+				Timezone:  "Asia/Tokyo",
+			},
+			expected: domain.GetTasksQuery{
+				TaskFiltersQuery: domain.TaskFiltersQuery{
+					CreatedAtDateFrom: "2025-03-12",
+					CreatedAtDateTo:   "2025-03-13",
+					UpdatedAtDateFrom: "2025-03-12",
+					Timezone:          "Asia/Tokyo",
+				},
+			},
+		},
+		{
+			name:          "Invalid date",
+			query:         domain.SmartListQuery{CreatedAt: domain.DateRange{From: "soon"}},
+			expectedError: `created_at: invalid date "soon"`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			query, err := testCase.query.TasksQuery(now)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, query)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"todo_list_go/internal/domain"
+)
+
+func TestParseTaskFilter(t *testing.T) {
+	category := func(title string) domain.TaskFilter {
+		return domain.TaskFilterCondition{Field: "category", Operator: domain.TaskFilterEqual, Value: title}
+	}
+	completed := domain.TaskFilterCondition{Field: "completed", Operator: domain.TaskFilterEqual, Value: "true"}
+
+	testTable := []struct {
+		name     string
+		expr     string
+		expected domain.TaskFilter
+	}{
+		{
+			name: "Example",
+			expr: "(category:Work OR category:Home) AND NOT completed AND created>2025-01-01",
+			expected: domain.TaskFilterAnd{
+				Left: domain.TaskFilterAnd{
+					Left:  domain.TaskFilterOr{Left: category("Work"), Right: category("Home")},
+					Right: domain.TaskFilterNot{Operand: completed},
+				},
+				Right: domain.TaskFilterCondition{Field: "created", Operator: domain.TaskFilterGreater, Value: "2025-01-01"},
+			},
+		},
+		{
+			name: "AND binds tighter than OR",
+			expr: "category:Work or category:Home and completed",
+			expected: domain.TaskFilterOr{
+				Left:  category("Work"),
+				Right: domain.TaskFilterAnd{Left: category("Home"), Right: completed},
+			},
+		},
+		{
+			name:     "Implicit AND",
+			expr:     "category:Work completed",
+			expected: domain.TaskFilterAnd{Left: category("Work"), Right: completed},
+		},
+		{
+			name:     "Quoted value",
+			expr:     `category:"Side \"projects\""`,
+			expected: category(`Side "projects"`),
+		},
+		{
+			name:     "Title contains",
+			expr:     "title:report",
+			expected: domain.TaskFilterCondition{Field: "title", Operator: domain.TaskFilterContains, Value: "report"},
+		},
+		{
+			name: "Normalized values",
+			expr: "Priority>=HIGH AND status!=Done AND overdue:FALSE",
+			expected: domain.TaskFilterAnd{
+				Left: domain.TaskFilterAnd{
+					Left:  domain.TaskFilterCondition{Field: "priority", Operator: domain.TaskFilterGreaterEqual, Value: "high"},
+					Right: domain.TaskFilterCondition{Field: "status", Operator: domain.TaskFilterNotEqual, Value: "done"},
+				},
+				Right: domain.TaskFilterCondition{Field: "overdue", Operator: domain.TaskFilterEqual, Value: "false"},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			filter, err := domain.ParseTaskFilter(testCase.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, filter)
+		})
+	}
+}
+
+func TestParseTaskFilterErrors(t *testing.T) {
+	testTable := []struct {
+		name             string
+		expr             string
+		expectedPosition int
+		expectedError    string
+	}{
+		{name: "Empty", expr: "  ", expectedPosition: 3, expectedError: "position 3: filter is empty"},
+		{name: "Unknown field", expr: "completed AND size>3", expectedPosition: 15, expectedError: `position 15: unknown field "size"`},
+		{
+			name:             "Unclosed parenthesis",
+			expr:             "(category:Work OR category:Home",
+			expectedPosition: 32,
+			expectedError:    `position 32: expected ")" to close "(" at position 1, got end of filter`,
+		},
+		{name: "Unexpected parenthesis", expr: "completed)", expectedPosition: 10, expectedError: `position 10: unexpected ")"`},
+		{name: "Missing value", expr: "category:", expectedPosition: 10, expectedError: `position 10: expected value of "category", got end of filter`},
+		{name: "Missing operator", expr: "tag", expectedPosition: 4, expectedError: `position 4: expected operator after "tag", got end of filter`},
+		{name: "Operator not allowed", expr: "status>todo", expectedPosition: 7, expectedError: `position 7: operator ">" can't be used with "status"`},
+		{
+			name:             "Invalid date",
+			expr:             "created>=01.01.2025",
+			expectedPosition: 10,
+			expectedError:    `position 10: expected date in format yyyy-mm-dd, got "01.01.2025"`,
+		},
+		{name: "Unknown priority", expr: "priority:asap", expectedPosition: 10, expectedError: `position 10: unknown task priority "asap"`},
+		{name: "Dangling operator", expr: "completed OR", expectedPosition: 13, expectedError: "position 13: expected condition, got end of filter"},
+		{name: "Unterminated quote", expr: `tag:"home`, expectedPosition: 5, expectedError: "position 5: unterminated quoted value"},
+		{name: "Bang", expr: "!completed", expectedPosition: 1, expectedError: `position 1: unexpected "!", use != or NOT`},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := domain.ParseTaskFilter(testCase.expr)
+			assert.EqualError(t, err, testCase.expectedError)
+
+			var filterErr *domain.TaskFilterError
+			if assert.ErrorAs(t, err, &filterErr) {
+				assert.Equal(t, testCase.expectedPosition, filterErr.Position)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"todo_list_go/internal/domain"
+)
+
+func TestTaskStatusCanTransitionTo(t *testing.T) {
+	testTable := []struct {
+		name     string
+		from     domain.TaskStatus
+		to       domain.TaskStatus
+		expected bool
+	}{
+		{name: "Start work", from: domain.TaskStatusTodo, to: domain.TaskStatusInProgress, expected: true},
+		{name: "Finish work", from: domain.TaskStatusInProgress, to: domain.TaskStatusDone, expected: true},
+		{name: "Block", from: domain.TaskStatusInProgress, to: domain.TaskStatusBlocked, expected: true},
+		{name: "Unblock", from: domain.TaskStatusBlocked, to: domain.TaskStatusInProgress, expected: true},
+		{name: "Reopen", from: domain.TaskStatusDone, to: domain.TaskStatusTodo, expected: true},
+		{name: "Keep status", from: domain.TaskStatusBlocked, to: domain.TaskStatusBlocked, expected: true},
+		{name: "Complete blocked", from: domain.TaskStatusBlocked, to: domain.TaskStatusDone, expected: false},
+		{name: "Block done", from: domain.TaskStatusDone, to: domain.TaskStatusBlocked, expected: false},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.from.CanTransitionTo(testCase.to))
+		})
+	}
+}
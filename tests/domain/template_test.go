@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"todo_list_go/internal/domain"
+)
+
+func TestSubstituteTemplateVariables(t *testing.T) {
+	testTable := []struct {
+		name            string
+		text            string
+		values          map[string]string
+		expected        string
+		expectedMissing []string
+	}{
+		{name: "No placeholders", text: "Write notes", expected: "Write notes"},
+		{name: "Substituted", text: "Release {{version}}", values: map[string]string{"version": "1.2"}, expected: "Release 1.2"},
+		{name: "Spaces inside braces", text: "Onboard {{ name }}", values: map[string]string{"name": "Ann"}, expected: "Onboard Ann"},
+		{name: "Repeated", text: "{{a}} and {{a}}", values: map[string]string{"a": "x"}, expected: "x and x"},
+		{name: "Empty value", text: "Release {{version}}", values: map[string]string{"version": ""}, expected: "Release"},
+		{
+			name:            "Missing",
+			text:            "{{team}} release {{version}} for {{team}}",
+			values:          map[string]string{"version": "1.2"},
+			expected:        "{{team}} release 1.2 for {{team}}",
+			expectedMissing: []string{"team"},
+		},
+		{name: "Not a placeholder", text: "Use {{ }} and {x}", expected: "Use {{ }} and {x}"},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, missing := domain.SubstituteTemplateVariables(testCase.text, testCase.values)
+			assert.Equal(t, testCase.expected, result)
+			assert.Equal(t, testCase.expectedMissing, missing)
+		})
+	}
+}
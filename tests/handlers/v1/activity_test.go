@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testActivityID = "8d2f4b61-3c7a-4e95-b1d8-6a0e9c5f2b47"
+
+func TestGetTaskHistory(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockActivity)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockActivity) {
+				s.EXPECT().GetTaskHistory(gomock.Any(), testTaskID, testUserID).Return([]domain.Activity{
+					{
+						ID:        testActivityID,
+						CreatedAt: createdAt,
+						UserID:    testUserID,
+						TaskID:    testTaskID,
+						TaskTitle: "Task",
+						Action:    domain.ActivityCompleted,
+						Changes: domain.FieldChanges{
+							{Field: "status", Before: "todo", After: "done"},
+							{Field: "due_time", Before: nil, After: "09:30"},
+						},
+					},
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `[{"id":"` + testActivityID + `","created_at":"2025-01-01T10:00:00Z","task_id":"` + testTaskID + `",` +
+				`"task_title":"Task","action":"completed","changes":[{"field":"status","before":"todo","after":"done"},` +
+				`{"field":"due_time","before":null,"after":"09:30"}]}]`,
+		},
+		{
+			name: "Task not found",
+			mockBehaviour: func(s *mockService.MockActivity) {
+				s.EXPECT().GetTaskHistory(gomock.Any(), testTaskID, testUserID).Return(nil, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			activity := mockService.NewMockActivity(c)
+			testCase.mockBehaviour(activity)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Activity: activity}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/tasks/:id/history", handler.UserIdentityMiddleware, handler.GetTaskHistory)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/tasks/"+testTaskID+"/history", nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetActivityFeed(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockActivity)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	activity := domain.Activity{
+		ID:        testActivityID,
+		CreatedAt: createdAt,
+		UserID:    testUserID,
+		TaskID:    testTaskID,
+		TaskTitle: "Task",
+		Action:    domain.ActivityDeleted,
+		Changes:   domain.FieldChanges{},
+	}
+	activityJSON := `{"id":"` + testActivityID + `","created_at":"2025-01-01T10:00:00Z","task_id":"` + testTaskID + `",` +
+		`"task_title":"Task","action":"deleted","changes":[]}`
+	cursor := ""
+	nextCursor := "next"
+
+	testTable := []struct {
+		name                 string
+		query                string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Page mode",
+			query: "?page=2&limit=1",
+			mockBehaviour: func(s *mockService.MockActivity) {
+				s.EXPECT().GetFeed(gomock.Any(), testUserID, domain.PaginationQuery{Page: 2, Limit: 1, Offset: 1}).
+					Return(service.ActivityListResult{Items: []domain.Activity{activity}, TotalItems: 3, TotalPages: 3}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":2,"limit":1,"total_pages":3,"total_items":3,"items":[` + activityJSON + `]}`,
+		},
+		{
+			name:  "Cursor mode",
+			query: "?cursor=",
+			mockBehaviour: func(s *mockService.MockActivity) {
+				s.EXPECT().GetFeed(gomock.Any(), testUserID, domain.PaginationQuery{Page: 1, Limit: 20, Cursor: &cursor}).
+					Return(service.ActivityListResult{Items: []domain.Activity{activity}, NextCursor: &nextCursor}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"limit":20,"cursor":"","next_cursor":"next","items":[` + activityJSON + `]}`,
+		},
+		{
+			name:  "Invalid cursor",
+			query: "?cursor=broken",
+			mockBehaviour: func(s *mockService.MockActivity) {
+				s.EXPECT().GetFeed(gomock.Any(), testUserID, gomock.Any()).
+					Return(service.ActivityListResult{}, customErrors.ErrInvalidCursor)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"invalid cursor"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			activity := mockService.NewMockActivity(c)
+			testCase.mockBehaviour(activity)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Activity: activity}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/activity", handler.UserIdentityMiddleware, handler.GetActivityFeed)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/activity"+testCase.query, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
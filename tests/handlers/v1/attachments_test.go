@@ -0,0 +1,245 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const (
+	testAttachmentID          = "5c1e9a47-2b8d-4f63-a0e5-7d9b3c6f1e28"
+	testAttachmentMaxFileSize = 1 << 20
+)
+
+func TestUploadAttachment(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockAttachment)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		fileName             string
+		fileContent          string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Ok",
+			fileName:    "notes.txt",
+			fileContent: "meeting notes",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Upload(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, inp service.UploadAttachmentInput) (domain.Attachment, error) {
+						content, err := io.ReadAll(inp.Content)
+						assert.NoError(t, err)
+						assert.Equal(t, "meeting notes", string(content))
+						assert.Equal(t, service.UploadAttachmentInput{
+							TaskID:   testTaskID,
+							UserID:   testUserID,
+							FileName: "notes.txt",
+							Size:     13,
+							Content:  inp.Content,
+						}, inp)
+
+						return domain.Attachment{
+							ID:          testAttachmentID,
+							CreatedAt:   createdAt,
+							TaskID:      testTaskID,
+							UserID:      testUserID,
+							FileName:    "notes.txt",
+							ContentType: "text/plain; charset=utf-8",
+							Size:        13,
+						}, nil
+					})
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testAttachmentID + `","created_at":"2025-01-01T10:00:00Z","file_name":"notes.txt",` +
+				`"content_type":"text/plain; charset=utf-8","size":13}`,
+		},
+		{
+			name:                 "No file",
+			mockBehaviour:        func(s *mockService.MockAttachment) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"file":"is required"}}}`,
+		},
+		{
+			name:        "Too large",
+			fileName:    "video.zip",
+			fileContent: "content",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(domain.Attachment{}, customErrors.ErrAttachmentTooLarge)
+			},
+			expectedStatusCode:   413,
+			expectedResponseBody: `{"error":{"type":"string","details":"file exceeds the maximum attachment size"}}`,
+		},
+		{
+			name:                 "Body too large",
+			fileName:             "video.zip",
+			fileContent:          strings.Repeat("a", testAttachmentMaxFileSize+20<<10),
+			mockBehaviour:        func(s *mockService.MockAttachment) {},
+			expectedStatusCode:   413,
+			expectedResponseBody: `{"error":{"type":"string","details":"file exceeds the maximum attachment size"}}`,
+		},
+		{
+			name:        "Content type not allowed",
+			fileName:    "script.sh",
+			fileContent: "#!/bin/sh",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(domain.Attachment{}, customErrors.ErrAttachmentContentType)
+			},
+			expectedStatusCode:   415,
+			expectedResponseBody: `{"error":{"type":"string","details":"file type is not allowed for attachments"}}`,
+		},
+		{
+			name:        "Quota exceeded",
+			fileName:    "notes.txt",
+			fileContent: "content",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(domain.Attachment{}, customErrors.ErrStorageQuotaExceeded)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"error":{"type":"string","details":"attachment storage quota exceeded"}}`,
+		},
+		{
+			name:        "Task not found",
+			fileName:    "notes.txt",
+			fileContent: "content",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(domain.Attachment{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			attachment := mockService.NewMockAttachment(c)
+			attachment.EXPECT().MaxFileSize().Return(int64(testAttachmentMaxFileSize))
+			testCase.mockBehaviour(attachment)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Attachments: attachment}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/attachments", handler.UserIdentityMiddleware, handler.UploadAttachment)
+
+			// Test request
+			body := &bytes.Buffer{}
+			form := multipart.NewWriter(body)
+			if testCase.fileName != "" {
+				part, err := form.CreateFormFile("file", testCase.fileName)
+				assert.NoError(t, err)
+				_, err = part.Write([]byte(testCase.fileContent))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, form.Close())
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/attachments", body)
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set("Content-Type", form.FormDataContentType())
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockAttachment)
+
+	testTable := []struct {
+		name                string
+		mockBehaviour       mockBehaviour
+		expectedStatusCode  int
+		expectedDisposition string
+		expectedBody        string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Download(gomock.Any(), testAttachmentID, testTaskID, testUserID).Return(domain.Attachment{
+					ID:          testAttachmentID,
+					TaskID:      testTaskID,
+					FileName:    "report 2025.pdf",
+					ContentType: "application/pdf",
+					Size:        8,
+				}, io.NopCloser(bytes.NewBufferString("%PDF-1.7")), nil)
+			},
+			expectedStatusCode:  200,
+			expectedDisposition: `attachment; filename="report 2025.pdf"`,
+			expectedBody:        "%PDF-1.7",
+		},
+		{
+			name: "Attachment not found",
+			mockBehaviour: func(s *mockService.MockAttachment) {
+				s.EXPECT().Download(gomock.Any(), testAttachmentID, testTaskID, testUserID).
+					Return(domain.Attachment{}, nil, customErrors.ErrAttachmentNotFound)
+			},
+			expectedStatusCode: 404,
+			expectedBody:       `{"error":{"type":"string","details":"attachment not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			attachment := mockService.NewMockAttachment(c)
+			testCase.mockBehaviour(attachment)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Attachments: attachment}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/tasks/:id/attachments/:attachment_id", handler.UserIdentityMiddleware, handler.DownloadAttachment)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/tasks/"+testTaskID+"/attachments/"+testAttachmentID, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedDisposition, w.Header().Get("Content-Disposition"))
+			assert.Equal(t, testCase.expectedBody, w.Body.String())
+		})
+	}
+}
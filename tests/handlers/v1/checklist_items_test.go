@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const (
+	testChecklistItemID      = "0f8e3a8c-5a4b-4c1e-9a57-3f3c2b8d1e01"
+	testOtherChecklistItemID = "7d2c6b1a-9e4f-4a3b-8c5d-1e2f3a4b5c6d"
+)
+
+func TestUpdateChecklistItem(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockChecklistItem, input service.UpdateChecklistItemInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	completed := true
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		inputItem            service.UpdateChecklistItemInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"completed": true, "complete_task": true}`,
+			inputItem: service.UpdateChecklistItemInput{
+				ID:           testChecklistItemID,
+				TaskID:       testTaskID,
+				UserID:       testUserID,
+				Completed:    &completed,
+				CompleteTask: true,
+			},
+			mockBehaviour: func(s *mockService.MockChecklistItem, input service.UpdateChecklistItemInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(domain.ChecklistItem{
+					ID:        testChecklistItemID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					TaskID:    testTaskID,
+					Title:     "Step",
+					Completed: true,
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testChecklistItemID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"title":"Step","completed":true,"position":0}`,
+		},
+		{
+			name:      "Item not found",
+			inputBody: `{"title": "Step"}`,
+			inputItem: service.UpdateChecklistItemInput{
+				ID:     testChecklistItemID,
+				TaskID: testTaskID,
+				UserID: testUserID,
+				Title:  func() *string { s := "Step"; return &s }(),
+			},
+			mockBehaviour: func(s *mockService.MockChecklistItem, input service.UpdateChecklistItemInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(domain.ChecklistItem{}, customErrors.ErrChecklistItemNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"checklist item not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			checklistItem := mockService.NewMockChecklistItem(c)
+			testCase.mockBehaviour(checklistItem, testCase.inputItem)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{ChecklistItems: checklistItem}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.PUT("api/v1/tasks/:id/items/:item_id", handler.UserIdentityMiddleware, handler.UpdateChecklistItem)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				"PUT",
+				"/api/v1/tasks/"+testTaskID+"/items/"+testChecklistItemID,
+				bytes.NewBufferString(testCase.inputBody),
+			)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestReorderChecklistItems(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockChecklistItem, itemIDs []string)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		itemIDs              []string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"item_ids": ["` + testOtherChecklistItemID + `", "` + testChecklistItemID + `"]}`,
+			itemIDs:   []string{testOtherChecklistItemID, testChecklistItemID},
+			mockBehaviour: func(s *mockService.MockChecklistItem, itemIDs []string) {
+				s.EXPECT().Reorder(gomock.Any(), testTaskID, testUserID, itemIDs).Return([]domain.ChecklistItem{}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `[]`,
+		},
+		{
+			name:      "Incomplete order",
+			inputBody: `{"item_ids": ["` + testChecklistItemID + `"]}`,
+			itemIDs:   []string{testChecklistItemID},
+			mockBehaviour: func(s *mockService.MockChecklistItem, itemIDs []string) {
+				s.EXPECT().Reorder(gomock.Any(), testTaskID, testUserID, itemIDs).Return(nil, customErrors.ErrInvalidChecklistOrder)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"checklist order must contain every item of the task exactly once"}}`,
+		},
+		{
+			name:                 "Invalid item id",
+			inputBody:            `{"item_ids": ["abc"]}`,
+			mockBehaviour:        func(s *mockService.MockChecklistItem, itemIDs []string) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"item_ids":"must be a valid UUID"}}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			checklistItem := mockService.NewMockChecklistItem(c)
+			testCase.mockBehaviour(checklistItem, testCase.itemIDs)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{ChecklistItems: checklistItem}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/items/reorder", handler.UserIdentityMiddleware, handler.ReorderChecklistItems)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				"POST",
+				"/api/v1/tasks/"+testTaskID+"/items/reorder",
+				bytes.NewBufferString(testCase.inputBody),
+			)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
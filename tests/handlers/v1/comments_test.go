@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testCommentID = "3e8b6f1a-9d2c-4a7e-b5f0-1c4d7e2a9b63"
+
+func TestCreateComment(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockComment, input service.CreateCommentInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.CreateCommentInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"body":"Waiting for the review"}`,
+			input:     service.CreateCommentInput{TaskID: testTaskID, UserID: testUserID, Body: "Waiting for the review"},
+			mockBehaviour: func(s *mockService.MockComment, input service.CreateCommentInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.Comment{
+					ID:        testCommentID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					TaskID:    testTaskID,
+					UserID:    testUserID,
+					Body:      "Waiting for the review",
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testCommentID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"author_id":"` + testUserID + `","body":"Waiting for the review"}`,
+		},
+		{
+			name:                 "Empty body",
+			inputBody:            `{"body":""}`,
+			mockBehaviour:        func(s *mockService.MockComment, input service.CreateCommentInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"body":"is required"}}}`,
+		},
+		{
+			name:      "Task not found",
+			inputBody: `{"body":"Comment"}`,
+			input:     service.CreateCommentInput{TaskID: testTaskID, UserID: testUserID, Body: "Comment"},
+			mockBehaviour: func(s *mockService.MockComment, input service.CreateCommentInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.Comment{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			comment := mockService.NewMockComment(c)
+			testCase.mockBehaviour(comment, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Comments: comment}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/comments", handler.UserIdentityMiddleware, handler.CreateComment)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/comments", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestUpdateComment(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockComment, input service.UpdateCommentInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.UpdateCommentInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"body":"Reviewed"}`,
+			input:     service.UpdateCommentInput{ID: testCommentID, TaskID: testTaskID, UserID: testUserID, Body: "Reviewed"},
+			mockBehaviour: func(s *mockService.MockComment, input service.UpdateCommentInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(domain.Comment{
+					ID:        testCommentID,
+					CreatedAt: createdAt,
+					UpdatedAt: updatedAt,
+					TaskID:    testTaskID,
+					UserID:    testUserID,
+					Body:      "Reviewed",
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testCommentID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-02T10:00:00Z",` +
+				`"author_id":"` + testUserID + `","body":"Reviewed"}`,
+		},
+		{
+			name:      "Not the author",
+			inputBody: `{"body":"Reviewed"}`,
+			input:     service.UpdateCommentInput{ID: testCommentID, TaskID: testTaskID, UserID: testUserID, Body: "Reviewed"},
+			mockBehaviour: func(s *mockService.MockComment, input service.UpdateCommentInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(domain.Comment{}, customErrors.ErrNotCommentAuthor)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"error":{"type":"string","details":"only the author can change the comment"}}`,
+		},
+		{
+			name:      "Comment not found",
+			inputBody: `{"body":"Reviewed"}`,
+			input:     service.UpdateCommentInput{ID: testCommentID, TaskID: testTaskID, UserID: testUserID, Body: "Reviewed"},
+			mockBehaviour: func(s *mockService.MockComment, input service.UpdateCommentInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(domain.Comment{}, customErrors.ErrCommentNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"comment not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			comment := mockService.NewMockComment(c)
+			testCase.mockBehaviour(comment, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Comments: comment}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.PUT("api/v1/tasks/:id/comments/:comment_id", handler.UserIdentityMiddleware, handler.UpdateComment)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				"PUT", "/api/v1/tasks/"+testTaskID+"/comments/"+testCommentID, bytes.NewBufferString(testCase.inputBody),
+			)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockComment)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockComment) {
+				s.EXPECT().Delete(gomock.Any(), testCommentID, testTaskID, testUserID).Return(nil)
+			},
+			expectedStatusCode: 204,
+		},
+		{
+			name: "Not the author",
+			mockBehaviour: func(s *mockService.MockComment) {
+				s.EXPECT().Delete(gomock.Any(), testCommentID, testTaskID, testUserID).Return(customErrors.ErrNotCommentAuthor)
+			},
+			expectedStatusCode:   403,
+			expectedResponseBody: `{"error":{"type":"string","details":"only the author can change the comment"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			comment := mockService.NewMockComment(c)
+			testCase.mockBehaviour(comment)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Comments: comment}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.DELETE("api/v1/tasks/:id/comments/:comment_id", handler.UserIdentityMiddleware, handler.DeleteComment)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("DELETE", "/api/v1/tasks/"+testTaskID+"/comments/"+testCommentID, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
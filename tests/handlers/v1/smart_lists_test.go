@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testSmartListID = "5d8b2f4a-1c6e-4e3b-8a7f-3b9c0d2e1f57"
+
+func TestCreateSmartList(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockSmartList, input service.CreateSmartListInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	falseValue := false
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.CreateSmartListInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			inputBody: `{"title":"Due this week","query":{"completed":false,"priorities":["high"],` +
+				`"due_date":{"relative":"this week"},"timezone":"Europe/Berlin","sort":"due_date"}}`,
+			input: service.CreateSmartListInput{
+				UserID: testUserID,
+				Title:  "Due this week",
+				Query: domain.SmartListQuery{
+					Completed:  &falseValue,
+					Priorities: []string{"high"},
+					DueDate:    domain.DateRange{Relative: "this week"},
+					Timezone:   "Europe/Berlin",
+					Sort:       "due_date",
+				},
+			},
+			mockBehaviour: func(s *mockService.MockSmartList, input service.CreateSmartListInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.SmartList{
+					ID:        testSmartListID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					UserID:    testUserID,
+					Title:     input.Title,
+					Query:     input.Query,
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testSmartListID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"title":"Due this week","query":{"completed":false,"archived":null,"category_ids":null,"overdue":null,` +
+				`"blocked":null,"priorities":["high"],"statuses":null,"tag_ids":null,"tags":null,"tag_match":"","q":"","filter":"",` +
+				`"created_at":{},"updated_at":{},"due_date":{"relative":"this week"},"timezone":"Europe/Berlin","sort":"due_date"}}`,
+		},
+		{
+			name:                 "Invalid query",
+			inputBody:            `{"title":"Urgent","query":{"priorities":["asap"],"sort":"size"}}`,
+			mockBehaviour:        func(s *mockService.MockSmartList, input service.CreateSmartListInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"query.priorities":"must be one of: none low medium high urgent","query.sort":"must be a comma-separated list of unique fields: created_at updated_at title priority due_date category_title relevance position, prefix a field with - for descending order"}}}`,
+		},
+		{
+			name:      "Unknown relative date",
+			inputBody: `{"title":"Soon","query":{"due_date":{"relative":"someday"}}}`,
+			input: service.CreateSmartListInput{
+				UserID: testUserID,
+				Title:  "Soon",
+				Query:  domain.SmartListQuery{DueDate: domain.DateRange{Relative: "someday"}},
+			},
+			mockBehaviour: func(s *mockService.MockSmartList, input service.CreateSmartListInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.SmartList{},
+					fmt.Errorf("%w: due_date: unknown relative date range \"someday\"", customErrors.ErrInvalidSmartListQuery))
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"invalid smart list query: due_date: unknown relative date range \"someday\""}}`,
+		},
+		{
+			name:      "Already exists",
+			inputBody: `{"title":"Soon"}`,
+			input:     service.CreateSmartListInput{UserID: testUserID, Title: "Soon"},
+			mockBehaviour: func(s *mockService.MockSmartList, input service.CreateSmartListInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.SmartList{}, customErrors.ErrSmartListAlreadyExists)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"smart list with such title already exists"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			smartList := mockService.NewMockSmartList(c)
+			testCase.mockBehaviour(smartList, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{SmartLists: smartList}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/smart-lists", handler.UserIdentityMiddleware, handler.CreateSmartList)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/smart-lists", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetSmartListTasks(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockSmartList, pagination domain.PaginationQuery)
+
+	testTable := []struct {
+		name                 string
+		queryString          string
+		pagination           domain.PaginationQuery
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Ok",
+			queryString: "?page=2&limit=10",
+			pagination:  domain.PaginationQuery{Page: 2, Limit: 10, Offset: 10},
+			mockBehaviour: func(s *mockService.MockSmartList, pagination domain.PaginationQuery) {
+				s.EXPECT().GetTasks(gomock.Any(), testSmartListID, testUserID, pagination).Return(
+					service.TaskListResult{Items: []service.TaskOutput{}, TotalPages: 1, TotalItems: 3}, nil,
+				)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":2,"limit":10,"total_pages":1,"total_items":3,"items":[]}`,
+		},
+		{
+			name:        "Cursor mode",
+			queryString: "?cursor=",
+			pagination:  domain.PaginationQuery{Page: 1, Limit: 20, Cursor: new(string)},
+			mockBehaviour: func(s *mockService.MockSmartList, pagination domain.PaginationQuery) {
+				s.EXPECT().GetTasks(gomock.Any(), testSmartListID, testUserID, pagination).Return(
+					service.TaskListResult{Items: []service.TaskOutput{}}, nil,
+				)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"limit":20,"cursor":"","items":[]}`,
+		},
+		{
+			name:       "Not found",
+			pagination: domain.PaginationQuery{Page: 1, Limit: 20},
+			mockBehaviour: func(s *mockService.MockSmartList, pagination domain.PaginationQuery) {
+				s.EXPECT().GetTasks(gomock.Any(), testSmartListID, testUserID, pagination).Return(
+					service.TaskListResult{}, customErrors.ErrSmartListNotFound,
+				)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"smart list not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			smartList := mockService.NewMockSmartList(c)
+			testCase.mockBehaviour(smartList, testCase.pagination)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{SmartLists: smartList}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/smart-lists/:id/tasks", handler.UserIdentityMiddleware, handler.GetSmartListTasks)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/smart-lists/"+testSmartListID+"/tasks"+testCase.queryString, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
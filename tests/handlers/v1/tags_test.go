@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testTagID = "3f2b8e4a-6c1d-4e5f-8a9b-0c1d2e3f4a5b"
+
+func TestCreateTag(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTag, input service.CreateTagInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		inputTag             service.CreateTagInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"title": "home", "color": "green"}`,
+			inputTag:  service.CreateTagInput{UserID: testUserID, Title: "home", Color: "green"},
+			mockBehaviour: func(s *mockService.MockTag, input service.CreateTagInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.Tag{
+					ID:        testTagID,
+					UserID:    testUserID,
+					CreatedAt: createdAt,
+					Title:     "home",
+					Color:     "green",
+				}, nil)
+			},
+			expectedStatusCode:   201,
+			expectedResponseBody: `{"id":"` + testTagID + `","created_at":"2025-01-01T10:00:00Z","title":"home","color":"green"}`,
+		},
+		{
+			name:                 "Title with comma",
+			inputBody:            `{"title": "home,work"}`,
+			mockBehaviour:        func(s *mockService.MockTag, input service.CreateTagInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"title":"must not contain \",\""}}}`,
+		},
+		{
+			name:      "Tag already exists",
+			inputBody: `{"title": "home"}`,
+			inputTag:  service.CreateTagInput{UserID: testUserID, Title: "home"},
+			mockBehaviour: func(s *mockService.MockTag, input service.CreateTagInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.Tag{}, customErrors.ErrTagAlreadyExists)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"tag with such title already exists"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			tag := mockService.NewMockTag(c)
+			testCase.mockBehaviour(tag, testCase.inputTag)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tags: tag}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tags", handler.UserIdentityMiddleware, handler.CreateTag)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tags", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
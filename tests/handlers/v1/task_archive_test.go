@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func TestArchiveTask(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	archivedAt := time.Date(2025, 1, 5, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Archive(gomock.Any(), testTaskID, testUserID).Return(service.TaskOutput{
+					ID:         testTaskID,
+					CreatedAt:  createdAt,
+					UpdatedAt:  archivedAt,
+					Category:   domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:      "Task",
+					Completed:  true,
+					Status:     domain.TaskStatusDone,
+					Tags:       []domain.Tag{},
+					ArchivedAt: &archivedAt,
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-05T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":true,"status":"done","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":"2025-01-05T10:00:00Z"}`,
+		},
+		{
+			name: "Task not found",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Archive(gomock.Any(), testTaskID, testUserID).Return(service.TaskOutput{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/archive", handler.UserIdentityMiddleware, handler.ArchiveTask)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/archive", nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestArchiveCompletedTasks(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"category_id":"` + testCategoryID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().ArchiveCompleted(gomock.Any(), testCategoryID, testUserID).Return(int64(3), nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"archived":3}`,
+		},
+		{
+			name:                 "Missing category",
+			inputBody:            `{}`,
+			mockBehaviour:        func(s *mockService.MockTask) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"category_id":"is required"}}}`,
+		},
+		{
+			name:      "Category not found",
+			inputBody: `{"category_id":"` + testCategoryID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().ArchiveCompleted(gomock.Any(), testCategoryID, testUserID).Return(int64(0), customErrors.ErrCategoryNotFound)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"category not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/archive-completed", handler.UserIdentityMiddleware, handler.ArchiveCompletedTasks)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/archive-completed", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func TestBulkTasks(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, input service.BulkTaskInput)
+
+	const otherTaskID = "0c4c4a3d-6f39-4d7a-a4a7-7d1c3f5b8e21"
+	trueValue := true
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.BulkTaskInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"action":"complete","task_ids":["` + testTaskID + `","` + otherTaskID + `"]}`,
+			input: service.BulkTaskInput{
+				UserID:  testUserID,
+				Action:  service.BulkTaskComplete,
+				TaskIDs: []string{testTaskID, otherTaskID},
+			},
+			mockBehaviour: func(s *mockService.MockTask, input service.BulkTaskInput) {
+				s.EXPECT().Bulk(gomock.Any(), input).Return([]service.BulkTaskItemResult{
+					{TaskID: testTaskID},
+					{TaskID: otherTaskID, Err: customErrors.ErrTaskNotFound},
+				}, service.UndoToken{}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"succeeded":1,"failed":1,"results":[{"id":"` + testTaskID + `","success":true},` +
+				`{"id":"` + otherTaskID + `","success":false,"error":"task not found"}]}`,
+		},
+		{
+			name:      "Filter target",
+			inputBody: `{"action":"move","category_id":"` + testCategoryID + `","filter":{"completed":true,"category_ids":["` + testCategoryID + `"]}}`,
+			input: service.BulkTaskInput{
+				UserID:     testUserID,
+				Action:     service.BulkTaskMove,
+				CategoryID: testCategoryID,
+				Filters:    &domain.TaskFiltersQuery{Completed: &trueValue, CategoryIDs: []string{testCategoryID}},
+			},
+			mockBehaviour: func(s *mockService.MockTask, input service.BulkTaskInput) {
+				s.EXPECT().Bulk(gomock.Any(), input).Return([]service.BulkTaskItemResult{}, service.UndoToken{}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"succeeded":0,"failed":0,"results":[]}`,
+		},
+		{
+			name:                 "No target",
+			inputBody:            `{"action":"delete"}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.BulkTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"task_ids":"is required"}}}`,
+		},
+		{
+			name:                 "Fields of another action",
+			inputBody:            `{"action":"move","task_ids":["` + testTaskID + `"],"priority":"high"}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.BulkTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"category_id":"is required","priority":"is not allowed"}}}`,
+		},
+		{
+			name:      "Too many tasks",
+			inputBody: `{"action":"delete","filter":{"completed":true}}`,
+			input: service.BulkTaskInput{
+				UserID:  testUserID,
+				Action:  service.BulkTaskDelete,
+				Filters: &domain.TaskFiltersQuery{Completed: &trueValue},
+			},
+			mockBehaviour: func(s *mockService.MockTask, input service.BulkTaskInput) {
+				s.EXPECT().Bulk(gomock.Any(), input).Return(nil, service.UndoToken{}, customErrors.ErrTooManyBulkTasks)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"too many tasks for a bulk operation"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/bulk", handler.UserIdentityMiddleware, handler.BulkTasks)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/bulk", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testBlockerID = "0c4c4a3d-6f39-4d7a-a4a7-7d1c3f5b8e21"
+
+func TestAddTaskDependency(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"blocker_id":"` + testBlockerID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().AddDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).Return(service.TaskOutput{
+					ID:        testTaskID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					Category:  domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:     "Task",
+					Tags:      []domain.Tag{},
+					BlockedBy: []string{testBlockerID},
+					Position:  "N",
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":false,"status":"todo","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":["` + testBlockerID + `"],"blocks":[],"attachments":[],"position":"N","archived_at":null}`,
+		},
+		{
+			name:                 "Invalid blocker id",
+			inputBody:            `{"blocker_id":"123"}`,
+			mockBehaviour:        func(s *mockService.MockTask) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"blocker_id":"must be a valid UUID"}}}`,
+		},
+		{
+			name:      "Cycle",
+			inputBody: `{"blocker_id":"` + testBlockerID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().AddDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).
+					Return(service.TaskOutput{}, customErrors.ErrTaskDependencyCycle)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"task dependency would create a cycle"}}`,
+		},
+		{
+			name:      "Blocker not found",
+			inputBody: `{"blocker_id":"` + testBlockerID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().AddDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).
+					Return(service.TaskOutput{}, customErrors.ErrBlockerNotFound)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"blocking task not found"}}`,
+		},
+		{
+			name:      "Already exists",
+			inputBody: `{"blocker_id":"` + testBlockerID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().AddDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).
+					Return(service.TaskOutput{}, customErrors.ErrTaskDependencyAlreadyExists)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"task is already blocked by this task"}}`,
+		},
+		{
+			name:      "Task not found",
+			inputBody: `{"blocker_id":"` + testBlockerID + `"}`,
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().AddDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).
+					Return(service.TaskOutput{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/dependencies", handler.UserIdentityMiddleware, handler.AddTaskDependency)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/dependencies", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestRemoveTaskDependency(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().RemoveDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).Return(nil)
+			},
+			expectedStatusCode: 204,
+		},
+		{
+			name: "Dependency not found",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().RemoveDependency(gomock.Any(), testTaskID, testBlockerID, testUserID).
+					Return(customErrors.ErrTaskDependencyNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task dependency not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.DELETE("api/v1/tasks/:id/dependencies/:blocker_id", handler.UserIdentityMiddleware, handler.RemoveTaskDependency)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("DELETE", "/api/v1/tasks/"+testTaskID+"/dependencies/"+testBlockerID, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func TestMoveTask(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, input service.MoveTaskInput)
+
+	const (
+		afterTaskID  = "0c4c4a3d-6f39-4d7a-a4a7-7d1c3f5b8e21"
+		beforeTaskID = "5f1d3a8e-2b6c-4e9f-8a7d-3c2b1a0f9e8d"
+	)
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.MoveTaskInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"after_id":"` + afterTaskID + `","before_id":"` + beforeTaskID + `"}`,
+			input:     service.MoveTaskInput{ID: testTaskID, UserID: testUserID, AfterID: afterTaskID, BeforeID: beforeTaskID},
+			mockBehaviour: func(s *mockService.MockTask, input service.MoveTaskInput) {
+				s.EXPECT().Move(gomock.Any(), input).Return(service.TaskOutput{
+					ID:        testTaskID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					Category:  domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:     "Task",
+					Tags:      []domain.Tag{},
+					Position:  "N",
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":false,"status":"todo","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"N","archived_at":null}`,
+		},
+		{
+			name:                 "No neighbours",
+			inputBody:            `{}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.MoveTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"before_id":"is required"}}}`,
+		},
+		{
+			name:      "Neighbour of another category",
+			inputBody: `{"before_id":"` + beforeTaskID + `"}`,
+			input:     service.MoveTaskInput{ID: testTaskID, UserID: testUserID, BeforeID: beforeTaskID},
+			mockBehaviour: func(s *mockService.MockTask, input service.MoveTaskInput) {
+				s.EXPECT().Move(gomock.Any(), input).Return(service.TaskOutput{}, customErrors.ErrTaskNeighbour)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"task neighbours must be other tasks of the same category"}}`,
+		},
+		{
+			name:      "Task not found",
+			inputBody: `{"after_id":"` + afterTaskID + `"}`,
+			input:     service.MoveTaskInput{ID: testTaskID, UserID: testUserID, AfterID: afterTaskID},
+			mockBehaviour: func(s *mockService.MockTask, input service.MoveTaskInput) {
+				s.EXPECT().Move(gomock.Any(), input).Return(service.TaskOutput{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/move", handler.UserIdentityMiddleware, handler.MoveTask)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/move", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func TestQuickAddTask(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, input service.QuickAddTaskInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	dueTime := "09:00"
+	dueAt := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	category := domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Finance", Color: "red"}
+	tag := domain.Tag{ID: testTagID, CreatedAt: createdAt, Title: "bills", Color: "blue"}
+	parsed := service.CreateTaskInput{
+		UserID:     testUserID,
+		CategoryID: testCategoryID,
+		Title:      "Pay rent",
+		Priority:   "high",
+		DueDate:    "2025-01-02",
+		DueTime:    "09:00",
+		TagIDs:     []string{testTagID},
+	}
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.QuickAddTaskInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"text":"Pay rent tomorrow 9am #Finance !high @bills"}`,
+			input:     service.QuickAddTaskInput{UserID: testUserID, Text: "Pay rent tomorrow 9am #Finance !high @bills"},
+			mockBehaviour: func(s *mockService.MockTask, input service.QuickAddTaskInput) {
+				s.EXPECT().QuickAdd(gomock.Any(), input).Return(service.QuickAddTaskResult{
+					Input:    parsed,
+					Category: category,
+					Tags:     []domain.Tag{tag},
+					Task: &service.TaskOutput{
+						ID:        testTaskID,
+						CreatedAt: createdAt,
+						UpdatedAt: createdAt,
+						Category:  category,
+						Title:     "Pay rent",
+						Status:    domain.TaskStatusTodo,
+						Priority:  domain.TaskPriorityHigh,
+						DueDate:   &dueDate,
+						DueTime:   &dueTime,
+						DueAt:     &dueAt,
+						Tags:      []domain.Tag{tag},
+					},
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Finance","description":"","color":"red"},` +
+				`"title":"Pay rent","description":"","completed":false,"status":"todo","priority":"high","due_date":"2025-01-02","due_time":"09:00","due_timezone":null,"due_at":"2025-01-02T09:00:00Z",` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,` +
+				`"tags":[{"id":"` + testTagID + `","created_at":"2025-01-01T10:00:00Z","title":"bills","color":"blue"}],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}`,
+		},
+		{
+			name:      "Dry run",
+			inputBody: `{"text":"Pay rent tomorrow 9am #Finance !high @bills","dry_run":true}`,
+			input:     service.QuickAddTaskInput{UserID: testUserID, Text: "Pay rent tomorrow 9am #Finance !high @bills", DryRun: true},
+			mockBehaviour: func(s *mockService.MockTask, input service.QuickAddTaskInput) {
+				s.EXPECT().QuickAdd(gomock.Any(), input).Return(service.QuickAddTaskResult{
+					Input:    parsed,
+					Category: category,
+					Tags:     []domain.Tag{tag},
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"title":"Pay rent",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Finance","description":"","color":"red"},` +
+				`"priority":"high","due_date":"2025-01-02","due_time":"09:00","due_timezone":null,` +
+				`"tags":[{"id":"` + testTagID + `","created_at":"2025-01-01T10:00:00Z","title":"bills","color":"blue"}]}`,
+		},
+		{
+			name:                 "Invalid timezone",
+			inputBody:            `{"text":"Pay rent","timezone":"Mars/Olympus"}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.QuickAddTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"timezone":"must be a valid IANA time zone"}}}`,
+		},
+		{
+			name:      "Unknown category",
+			inputBody: `{"text":"Pay rent #Bills"}`,
+			input:     service.QuickAddTaskInput{UserID: testUserID, Text: "Pay rent #Bills"},
+			mockBehaviour: func(s *mockService.MockTask, input service.QuickAddTaskInput) {
+				s.EXPECT().QuickAdd(gomock.Any(), input).Return(
+					service.QuickAddTaskResult{}, fmt.Errorf("%w: Bills", customErrors.ErrCategoryNotFound),
+				)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"category not found: Bills"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/quick", handler.UserIdentityMiddleware, handler.QuickAddTask)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/quick", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
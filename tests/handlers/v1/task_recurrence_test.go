@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testSeriesID = "c5a1f0d2-8b3e-4f6a-9d7c-2e1b0a9f8e7d"
+
+func TestSetTaskRecurrence(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, recurrence domain.Recurrence)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	rule := "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR"
+	seriesID := testSeriesID
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		recurrence           domain.Recurrence
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"frequency": "weekly", "interval": 2, "weekdays": ["fr", "mo"]}`,
+			recurrence: domain.Recurrence{
+				Frequency: domain.RecurrenceWeekly,
+				Interval:  2,
+				Weekdays:  []time.Weekday{time.Monday, time.Friday},
+			},
+			mockBehaviour: func(s *mockService.MockTask, recurrence domain.Recurrence) {
+				s.EXPECT().SetRecurrence(gomock.Any(), testTaskID, testUserID, recurrence).Return(service.TaskOutput{
+					ID:             testTaskID,
+					CreatedAt:      createdAt,
+					UpdatedAt:      createdAt,
+					Category:       domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:          "Task",
+					DueDate:        &dueDate,
+					RecurrenceRule: &rule,
+					SeriesID:       &seriesID,
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":false,"status":"todo","priority":"none","due_date":"2025-01-10","due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":{"frequency":"weekly","interval":2,"weekdays":["mo","fr"]},"series_id":"` + testSeriesID + `","tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}`,
+		},
+		{
+			name:                 "Invalid schedule",
+			inputBody:            `{"frequency": "weekly", "month_day": 5}`,
+			mockBehaviour:        func(s *mockService.MockTask, recurrence domain.Recurrence) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"month_day":"is not allowed","weekdays":"is required"}}}`,
+		},
+		{
+			name:                 "Invalid weekday",
+			inputBody:            `{"frequency": "weekly", "weekdays": ["mo", "monday"]}`,
+			mockBehaviour:        func(s *mockService.MockTask, recurrence domain.Recurrence) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"weekdays":"must be one of: mo tu we th fr sa su"}}}`,
+		},
+		{
+			name:       "Task without due date",
+			inputBody:  `{"frequency": "after_completion", "interval": 3}`,
+			recurrence: domain.Recurrence{Frequency: domain.RecurrenceAfterCompletion, Interval: 3},
+			mockBehaviour: func(s *mockService.MockTask, recurrence domain.Recurrence) {
+				s.EXPECT().SetRecurrence(gomock.Any(), testTaskID, testUserID, recurrence).Return(
+					service.TaskOutput{}, customErrors.ErrRecurrenceDueDate,
+				)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"recurring task must have a due date"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.recurrence)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.PUT("api/v1/tasks/:id/recurrence", handler.UserIdentityMiddleware, handler.SetTaskRecurrence)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				"PUT", "/api/v1/tasks/"+testTaskID+"/recurrence", bytes.NewBufferString(testCase.inputBody),
+			)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetTaskOccurrences(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, count int)
+
+	dueDate := time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)
+	dueAt := time.Date(2025, 1, 17, 23, 59, 59, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		queryString          string
+		count                int
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Ok",
+			count: 5,
+			mockBehaviour: func(s *mockService.MockTask, count int) {
+				s.EXPECT().GetOccurrences(gomock.Any(), testTaskID, testUserID, count).Return(
+					[]domain.TaskDue{{Date: &dueDate, At: &dueAt}}, nil,
+				)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `[{"due_date":"2025-01-17","due_time":null,"due_timezone":null,"due_at":"2025-01-17T23:59:59Z"}]`,
+		},
+		{
+			name:        "Not recurring",
+			queryString: "?count=10",
+			count:       10,
+			mockBehaviour: func(s *mockService.MockTask, count int) {
+				s.EXPECT().GetOccurrences(gomock.Any(), testTaskID, testUserID, count).Return(nil, customErrors.ErrTaskNotRecurring)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"task is not recurring"}}`,
+		},
+		{
+			name:                 "Invalid count",
+			queryString:          "?count=100",
+			mockBehaviour:        func(s *mockService.MockTask, count int) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"count":"must be at most 50"}}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.count)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/tasks/:id/occurrences", handler.UserIdentityMiddleware, handler.GetTaskOccurrences)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/tasks/"+testTaskID+"/occurrences"+testCase.queryString, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,546 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const (
+	testUserID     = "9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d"
+	testCategoryID = "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed"
+	testTaskID     = "6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b"
+)
+
+func TestCreateTask(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, input service.CreateTaskInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	dueTime := "09:30"
+	dueTimezone := "Europe/Kyiv"
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		inputTask            service.CreateTaskInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"category_id": "` + testCategoryID + `", "title": "Task", "description": "", "due_date": "2025-01-10", "due_time": "09:30", "due_timezone": "Europe/Kyiv"}`,
+			inputTask: service.CreateTaskInput{
+				UserID:      testUserID,
+				CategoryID:  testCategoryID,
+				Title:       "Task",
+				DueDate:     "2025-01-10",
+				DueTime:     "09:30",
+				DueTimezone: "Europe/Kyiv",
+			},
+			mockBehaviour: func(s *mockService.MockTask, input service.CreateTaskInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(service.TaskOutput{
+					ID:          testTaskID,
+					CreatedAt:   createdAt,
+					UpdatedAt:   createdAt,
+					Category:    domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:       "Task",
+					DueDate:     &dueDate,
+					DueTime:     &dueTime,
+					DueTimezone: &dueTimezone,
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":false,"status":"todo","priority":"none","due_date":"2025-01-10","due_time":"09:30","due_timezone":"Europe/Kyiv","due_at":null,"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}`,
+		},
+		{
+			name:                 "Invalid due fields",
+			inputBody:            `{"category_id": "` + testCategoryID + `", "title": "Task", "due_date": "10.01.2025", "due_time": "9am", "due_timezone": "Mars/Base"}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.CreateTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"due_date":"must match the format yyyy-mm-dd","due_time":"must match the format hh:mm","due_timezone":"must be a valid IANA time zone"}}}`,
+		},
+		{
+			name:                 "Invalid recurrence",
+			inputBody:            `{"category_id": "` + testCategoryID + `", "title": "Task", "due_date": "2025-01-10", "recurrence": {"frequency": "monthly", "month_day": 32}}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.CreateTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"recurrence.month_day":"must be at most 31"}}}`,
+		},
+		{
+			name:      "Due time without due date",
+			inputBody: `{"category_id": "` + testCategoryID + `", "title": "Task", "due_time": "09:30"}`,
+			inputTask: service.CreateTaskInput{
+				UserID:     testUserID,
+				CategoryID: testCategoryID,
+				Title:      "Task",
+				DueTime:    "09:30",
+			},
+			mockBehaviour: func(s *mockService.MockTask, input service.CreateTaskInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(service.TaskOutput{}, customErrors.ErrDueDateRequired)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"due date is required when due time or timezone is set"}}`,
+		},
+		{
+			name:      "Description too long",
+			inputBody: `{"category_id": "` + testCategoryID + `", "title": "Task", "description": "Long notes"}`,
+			inputTask: service.CreateTaskInput{
+				UserID:      testUserID,
+				CategoryID:  testCategoryID,
+				Title:       "Task",
+				Description: "Long notes",
+			},
+			mockBehaviour: func(s *mockService.MockTask, input service.CreateTaskInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(service.TaskOutput{}, customErrors.ErrTaskDescriptionTooLong)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"task description exceeds the maximum length"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.inputTask)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks", handler.UserIdentityMiddleware, handler.CreateTask)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetTaskById(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	task := service.TaskOutput{
+		ID:          testTaskID,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+		Category:    domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+		Title:       "Task",
+		Description: "**Steps** <script>alert(1)</script>",
+	}
+
+	testTable := []struct {
+		name                 string
+		query                string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Markdown",
+			query: "",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().GetByID(gomock.Any(), testTaskID, testUserID).Return(task, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"**Steps** \u003cscript\u003ealert(1)\u003c/script\u003e","completed":false,"status":"todo","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}`,
+		},
+		{
+			name:  "HTML",
+			query: "?format=html",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().GetByID(gomock.Any(), testTaskID, testUserID).Return(task, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"**Steps** \u003cscript\u003ealert(1)\u003c/script\u003e","description_html":"\u003cp\u003e\u003cstrong\u003eSteps\u003c/strong\u003e alert(1)\u003c/p\u003e","completed":false,"status":"todo","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}`,
+		},
+		{
+			name:                 "Invalid format",
+			query:                "?format=pdf",
+			mockBehaviour:        func(s *mockService.MockTask) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"format":"must be one of: markdown html"}}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/tasks/:id", handler.UserIdentityMiddleware, handler.GetTaskById)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/tasks/"+testTaskID+testCase.query, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetAllTasks(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, query domain.GetTasksQuery)
+
+	trueValue := true
+
+	testTable := []struct {
+		name                 string
+		queryString          string
+		query                domain.GetTasksQuery
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:        "Ok",
+			queryString: "?priorities=high,urgent&sort=priority",
+			query: domain.GetTasksQuery{
+				PaginationQuery:  domain.PaginationQuery{Page: 1, Limit: 20},
+				TaskFiltersQuery: domain.TaskFiltersQuery{Priorities: []string{"high", "urgent"}},
+				Sort:             "priority",
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(service.TaskListResult{Items: []service.TaskOutput{}}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":1,"limit":20,"total_pages":0,"total_items":0,"items":[]}`,
+		},
+		{
+			name:        "Cursor mode",
+			queryString: "?cursor=&limit=10",
+			query: domain.GetTasksQuery{
+				PaginationQuery: domain.PaginationQuery{Page: 1, Limit: 10, Cursor: new(string)},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				nextCursor := "next"
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(
+					service.TaskListResult{Items: []service.TaskOutput{}, NextCursor: &nextCursor}, nil,
+				)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"limit":10,"cursor":"","next_cursor":"next","items":[]}`,
+		},
+		{
+			name:        "Invalid cursor",
+			queryString: "?cursor=abc",
+			query: domain.GetTasksQuery{
+				PaginationQuery: domain.PaginationQuery{Page: 1, Limit: 20, Cursor: func() *string { c := "abc"; return &c }()},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(service.TaskListResult{}, customErrors.ErrInvalidCursor)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"invalid cursor"}}`,
+		},
+		{
+			name:        "Date filters in time zone",
+			queryString: "?createdAtDateFrom=2025-01-01&createdAtDateTo=2025-01-31&updatedAtDateFrom=2025-01-15&timezone=Europe/Berlin",
+			query: domain.GetTasksQuery{
+				PaginationQuery: domain.PaginationQuery{Page: 1, Limit: 20},
+				TaskFiltersQuery: domain.TaskFiltersQuery{
+					CreatedAtDateFrom: "2025-01-01",
+					CreatedAtDateTo:   "2025-01-31",
+					UpdatedAtDateFrom: "2025-01-15",
+					Timezone:          "Europe/Berlin",
+				},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(service.TaskListResult{Items: []service.TaskOutput{}}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":1,"limit":20,"total_pages":0,"total_items":0,"items":[]}`,
+		},
+		{
+			name:                 "Invalid date filters",
+			queryString:          "?updatedAtDateTo=31.01.2025&timezone=Mars/Base",
+			mockBehaviour:        func(s *mockService.MockTask, query domain.GetTasksQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"timezone":"must be a valid IANA time zone","updatedAtDateTo":"must match the format yyyy-mm-dd"}}}`,
+		},
+		{
+			name:        "Invalid filter",
+			queryString: "?filter=category:Work+AND+size>3",
+			query: domain.GetTasksQuery{
+				PaginationQuery:  domain.PaginationQuery{Page: 1, Limit: 20},
+				TaskFiltersQuery: domain.TaskFiltersQuery{Filter: "category:Work AND size>3"},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(
+					service.TaskListResult{},
+					fmt.Errorf("%w: position 19: unknown field \"size\"", customErrors.ErrInvalidTaskFilter),
+				)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"invalid task filter: position 19: unknown field \"size\""}}`,
+		},
+		{
+			name:        "Tag filters",
+			queryString: "?tagIds=" + testTagID + "," + testTagID + "&tags=home,work&tagMatch=all",
+			query: domain.GetTasksQuery{
+				PaginationQuery: domain.PaginationQuery{Page: 1, Limit: 20},
+				TaskFiltersQuery: domain.TaskFiltersQuery{
+					TagIDs:   []string{testTagID},
+					Tags:     []string{"home", "work"},
+					TagMatch: domain.TagMatchAll,
+				},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(service.TaskListResult{Items: []service.TaskOutput{}}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":1,"limit":20,"total_pages":0,"total_items":0,"items":[]}`,
+		},
+		{
+			name:        "Status filter",
+			queryString: "?status=in_progress,blocked",
+			query: domain.GetTasksQuery{
+				PaginationQuery:  domain.PaginationQuery{Page: 1, Limit: 20},
+				TaskFiltersQuery: domain.TaskFiltersQuery{Statuses: []string{"in_progress", "blocked"}},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(service.TaskListResult{Items: []service.TaskOutput{}}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":1,"limit":20,"total_pages":0,"total_items":0,"items":[]}`,
+		},
+		{
+			name:        "Archived tasks",
+			queryString: "?archived=true&completed=true",
+			query: domain.GetTasksQuery{
+				PaginationQuery:  domain.PaginationQuery{Page: 1, Limit: 20},
+				TaskFiltersQuery: domain.TaskFiltersQuery{Completed: &trueValue, Archived: &trueValue},
+			},
+			mockBehaviour: func(s *mockService.MockTask, query domain.GetTasksQuery) {
+				s.EXPECT().GetList(gomock.Any(), testUserID, query).Return(service.TaskListResult{Items: []service.TaskOutput{}}, nil)
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"page":1,"limit":20,"total_pages":0,"total_items":0,"items":[]}`,
+		},
+		{
+			name:                 "Invalid tag filters",
+			queryString:          "?tagIds=" + testTagID + ",home&tagMatch=every",
+			mockBehaviour:        func(s *mockService.MockTask, query domain.GetTasksQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"tagIds":"must be a comma-separated list of UUIDs","tagMatch":"must be one of: any all"}}}`,
+		},
+		{
+			name:                 "Relevance sort without search",
+			queryString:          "?sort=-relevance",
+			mockBehaviour:        func(s *mockService.MockTask, query domain.GetTasksQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"sort":"relevance can be used only with q"}}}`,
+		},
+		{
+			name:                 "Duplicate sort field",
+			queryString:          "?sort=title,-title",
+			mockBehaviour:        func(s *mockService.MockTask, query domain.GetTasksQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"sort":"must be a comma-separated list of unique fields: created_at updated_at title priority due_date category_title relevance position, prefix a field with - for descending order"}}}`,
+		},
+		{
+			name:                 "Invalid priorities and sort",
+			queryString:          "?priorities=high,asap&sort=-title,color",
+			mockBehaviour:        func(s *mockService.MockTask, query domain.GetTasksQuery) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"priorities":"must be a comma-separated list of: none low medium high urgent","sort":"must be a comma-separated list of unique fields: created_at updated_at title priority due_date category_title relevance position, prefix a field with - for descending order"}}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.query)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/tasks", handler.UserIdentityMiddleware, handler.GetAllTasks)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/tasks"+testCase.queryString, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask, input service.UpdateTaskInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	inProgress := "in_progress"
+	done := "done"
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		inputTask            service.UpdateTaskInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+		expectedUndoToken    string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"status": "in_progress"}`,
+			inputTask: service.UpdateTaskInput{ID: testTaskID, UserID: testUserID, Status: &inProgress},
+			mockBehaviour: func(s *mockService.MockTask, input service.UpdateTaskInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(service.TaskOutput{
+					ID:        testTaskID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					Category:  domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:     "Task",
+					Status:    domain.TaskStatusInProgress,
+					Tags:      []domain.Tag{},
+				}, service.UndoToken{Token: testUndoToken, ExpiresAt: createdAt.Add(5 * time.Minute)}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedUndoToken:  testUndoToken,
+			expectedResponseBody: `{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":false,"status":"in_progress","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}`,
+		},
+		{
+			name:                 "Invalid status",
+			inputBody:            `{"status": "paused"}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.UpdateTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"status":"must be one of: todo in_progress blocked done"}}}`,
+		},
+		{
+			name:      "Status transition not allowed",
+			inputBody: `{"status": "done"}`,
+			inputTask: service.UpdateTaskInput{ID: testTaskID, UserID: testUserID, Status: &done},
+			mockBehaviour: func(s *mockService.MockTask, input service.UpdateTaskInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(
+					service.TaskOutput{}, service.UndoToken{}, fmt.Errorf("%w: blocked to done", customErrors.ErrTaskStatusTransition),
+				)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"task status transition is not allowed: blocked to done"}}`,
+		},
+		{
+			name:                 "Negative estimate",
+			inputBody:            `{"estimate_minutes": -5}`,
+			mockBehaviour:        func(s *mockService.MockTask, input service.UpdateTaskInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"estimate_minutes":"must be at least 0"}}}`,
+		},
+		{
+			name:      "Blocked by open tasks",
+			inputBody: `{"status": "done"}`,
+			inputTask: service.UpdateTaskInput{ID: testTaskID, UserID: testUserID, Status: &done},
+			mockBehaviour: func(s *mockService.MockTask, input service.UpdateTaskInput) {
+				s.EXPECT().Update(gomock.Any(), input).Return(service.TaskOutput{}, service.UndoToken{}, customErrors.ErrTaskBlocked)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"task is blocked by open tasks"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task, testCase.inputTask)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.PUT("api/v1/tasks/:id", handler.UserIdentityMiddleware, handler.UpdateTask)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("PUT", "/api/v1/tasks/"+testTaskID, bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+			assert.Equal(t, testCase.expectedUndoToken, w.Header().Get("X-Undo-Token"))
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testTemplateID = "2a6c8e1f-4b3d-4f7a-9c5e-8d1b0f3a6e24"
+
+func TestCreateTemplate(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTemplate, input service.CreateTemplateInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	offset := 2
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.CreateTemplateInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			inputBody: `{"title":"Release","tasks":[{"category_id":"` + testCategoryID + `","title":"Release {{version}}",` +
+				`"due_offset_days":2,"checklist_items":["Tag {{version}}"]}]}`,
+			input: service.CreateTemplateInput{
+				UserID: testUserID,
+				Title:  "Release",
+				Tasks: []domain.TemplateTask{{
+					CategoryID:     testCategoryID,
+					Title:          "Release {{version}}",
+					DueOffsetDays:  &offset,
+					ChecklistItems: []string{"Tag {{version}}"},
+				}},
+			},
+			mockBehaviour: func(s *mockService.MockTemplate, input service.CreateTemplateInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.TaskTemplate{
+					ID:        testTemplateID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					UserID:    testUserID,
+					Title:     "Release",
+					Tasks:     input.Tasks,
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testTemplateID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"title":"Release","description":"","tasks":[{"category_id":"` + testCategoryID + `","title":"Release {{version}}",` +
+				`"description":"","priority":"","due_offset_days":2,"due_time":"","due_timezone":"","estimate_minutes":0,` +
+				`"checklist_items":["Tag {{version}}"]}]}`,
+		},
+		{
+			name:                 "Invalid task",
+			inputBody:            `{"title":"Release","tasks":[{"category_id":"` + testCategoryID + `","title":"","priority":"asap"}]}`,
+			mockBehaviour:        func(s *mockService.MockTemplate, input service.CreateTemplateInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"tasks.priority":"must be one of: none low medium high urgent","tasks.title":"is required"}}}`,
+		},
+		{
+			name:      "Category not found",
+			inputBody: `{"title":"Release","tasks":[{"category_id":"` + testCategoryID + `","title":"Release"}]}`,
+			input: service.CreateTemplateInput{
+				UserID: testUserID,
+				Title:  "Release",
+				Tasks:  []domain.TemplateTask{{CategoryID: testCategoryID, Title: "Release"}},
+			},
+			mockBehaviour: func(s *mockService.MockTemplate, input service.CreateTemplateInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.TaskTemplate{}, customErrors.ErrCategoryNotFound)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"category not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			template := mockService.NewMockTemplate(c)
+			testCase.mockBehaviour(template, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Templates: template}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/templates", handler.UserIdentityMiddleware, handler.CreateTemplate)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/templates", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestInstantiateTemplate(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTemplate, input service.InstantiateTemplateInput)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.InstantiateTemplateInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"start_date":"2025-01-01","variables":{"version":"1.2"}}`,
+			input: service.InstantiateTemplateInput{
+				ID:        testTemplateID,
+				UserID:    testUserID,
+				StartDate: "2025-01-01",
+				Variables: map[string]string{"version": "1.2"},
+			},
+			mockBehaviour: func(s *mockService.MockTemplate, input service.InstantiateTemplateInput) {
+				s.EXPECT().Instantiate(gomock.Any(), input).Return([]service.TaskOutput{{
+					ID:             testTaskID,
+					CreatedAt:      createdAt,
+					UpdatedAt:      createdAt,
+					Category:       domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:          "Release 1.2",
+					Status:         domain.TaskStatusTodo,
+					DueDate:        &dueDate,
+					DueAt:          &dueDate,
+					ChecklistTotal: 1,
+					Tags:           []domain.Tag{},
+				}}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `[{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Release 1.2","description":"","completed":false,"status":"todo","priority":"none","due_date":"2025-01-03","due_time":null,"due_timezone":null,"due_at":"2025-01-03T00:00:00Z",` +
+				`"checklist":{"done":0,"total":1},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}]`,
+		},
+		{
+			name:                 "Invalid start date",
+			inputBody:            `{"start_date":"01.01.2025"}`,
+			mockBehaviour:        func(s *mockService.MockTemplate, input service.InstantiateTemplateInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"start_date":"must match the format yyyy-mm-dd"}}}`,
+		},
+		{
+			name:      "Missing variable",
+			inputBody: `{}`,
+			input:     service.InstantiateTemplateInput{ID: testTemplateID, UserID: testUserID},
+			mockBehaviour: func(s *mockService.MockTemplate, input service.InstantiateTemplateInput) {
+				s.EXPECT().Instantiate(gomock.Any(), input).Return(
+					nil, fmt.Errorf("%w: version", customErrors.ErrTemplateVariableMissing),
+				)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"template variable is missing: version"}}`,
+		},
+		{
+			name:      "Substituted title too long",
+			inputBody: `{"variables":{"version":"1.2"}}`,
+			input: service.InstantiateTemplateInput{
+				ID:        testTemplateID,
+				UserID:    testUserID,
+				Variables: map[string]string{"version": "1.2"},
+			},
+			mockBehaviour: func(s *mockService.MockTemplate, input service.InstantiateTemplateInput) {
+				s.EXPECT().Instantiate(gomock.Any(), input).Return(
+					nil, fmt.Errorf("%w: title of task 1 must be 1 to 255 characters", customErrors.ErrInvalidTemplateTask),
+				)
+			},
+			expectedStatusCode: 400,
+			expectedResponseBody: `{"error":{"type":"string","details":"invalid template task after variable substitution: ` +
+				`title of task 1 must be 1 to 255 characters"}}`,
+		},
+		{
+			name:      "Template instantiated again",
+			inputBody: `{"variables":{"version":"1.2"}}`,
+			input: service.InstantiateTemplateInput{
+				ID:        testTemplateID,
+				UserID:    testUserID,
+				Variables: map[string]string{"version": "1.2"},
+			},
+			mockBehaviour: func(s *mockService.MockTemplate, input service.InstantiateTemplateInput) {
+				s.EXPECT().Instantiate(gomock.Any(), input).Return(nil, customErrors.ErrTaskAlreadyExists)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"task with such title already exists"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			template := mockService.NewMockTemplate(c)
+			testCase.mockBehaviour(template, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Templates: template}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/templates/:id/instantiate", handler.UserIdentityMiddleware, handler.InstantiateTemplate)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(
+				"POST", "/api/v1/templates/"+testTemplateID+"/instantiate", bytes.NewBufferString(testCase.inputBody),
+			)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
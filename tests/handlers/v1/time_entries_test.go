@@ -0,0 +1,347 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testTimeEntryID = "7a2d9c4e-1b3f-4e8a-9c6d-5f0e2b1a3d47"
+
+func TestStartTaskTimer(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTimeEntry)
+
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockTimeEntry) {
+				s.EXPECT().StartTimer(gomock.Any(), testTaskID, testUserID).Return(domain.TimeEntry{
+					ID:        testTimeEntryID,
+					CreatedAt: startedAt,
+					UserID:    testUserID,
+					TaskID:    testTaskID,
+					StartedAt: startedAt,
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testTimeEntryID + `","created_at":"2025-01-01T10:00:00Z","task_id":"` + testTaskID + `",` +
+				`"started_at":"2025-01-01T10:00:00Z","ended_at":null,"running":true,"minutes":null}`,
+		},
+		{
+			name: "Another timer is running",
+			mockBehaviour: func(s *mockService.MockTimeEntry) {
+				s.EXPECT().StartTimer(gomock.Any(), testTaskID, testUserID).
+					Return(domain.TimeEntry{}, customErrors.ErrTimerAlreadyRunning)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"another timer is already running, stop it first"}}`,
+		},
+		{
+			name: "Task not found",
+			mockBehaviour: func(s *mockService.MockTimeEntry) {
+				s.EXPECT().StartTimer(gomock.Any(), testTaskID, testUserID).Return(domain.TimeEntry{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			timeEntry := mockService.NewMockTimeEntry(c)
+			testCase.mockBehaviour(timeEntry)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{TimeEntries: timeEntry}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/timer/start", handler.UserIdentityMiddleware, handler.StartTaskTimer)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/timer/start", nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestStopTaskTimer(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTimeEntry)
+
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(90 * time.Minute)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockTimeEntry) {
+				s.EXPECT().StopTimer(gomock.Any(), testTaskID, testUserID).Return(domain.TimeEntry{
+					ID:        testTimeEntryID,
+					CreatedAt: startedAt,
+					UserID:    testUserID,
+					TaskID:    testTaskID,
+					StartedAt: startedAt,
+					EndedAt:   &endedAt,
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"id":"` + testTimeEntryID + `","created_at":"2025-01-01T10:00:00Z","task_id":"` + testTaskID + `",` +
+				`"started_at":"2025-01-01T10:00:00Z","ended_at":"2025-01-01T11:30:00Z","running":false,"minutes":90}`,
+		},
+		{
+			name: "Timer is not running",
+			mockBehaviour: func(s *mockService.MockTimeEntry) {
+				s.EXPECT().StopTimer(gomock.Any(), testTaskID, testUserID).Return(domain.TimeEntry{}, customErrors.ErrTimerNotRunning)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"timer of the task is not running"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			timeEntry := mockService.NewMockTimeEntry(c)
+			testCase.mockBehaviour(timeEntry)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{TimeEntries: timeEntry}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/timer/stop", handler.UserIdentityMiddleware, handler.StopTaskTimer)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/timer/stop", nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestCreateTimeEntry(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTimeEntry, input service.CreateTimeEntryInput)
+
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(45 * time.Minute)
+
+	testTable := []struct {
+		name                 string
+		inputBody            string
+		input                service.CreateTimeEntryInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:      "Ok",
+			inputBody: `{"started_at":"2025-01-01T10:00:00Z","minutes":45}`,
+			input:     service.CreateTimeEntryInput{TaskID: testTaskID, UserID: testUserID, StartedAt: startedAt, Minutes: 45},
+			mockBehaviour: func(s *mockService.MockTimeEntry, input service.CreateTimeEntryInput) {
+				s.EXPECT().Create(gomock.Any(), input).Return(domain.TimeEntry{
+					ID:        testTimeEntryID,
+					CreatedAt: startedAt,
+					UserID:    testUserID,
+					TaskID:    testTaskID,
+					StartedAt: startedAt,
+					EndedAt:   &endedAt,
+				}, nil)
+			},
+			expectedStatusCode: 201,
+			expectedResponseBody: `{"id":"` + testTimeEntryID + `","created_at":"2025-01-01T10:00:00Z","task_id":"` + testTaskID + `",` +
+				`"started_at":"2025-01-01T10:00:00Z","ended_at":"2025-01-01T10:45:00Z","running":false,"minutes":45}`,
+		},
+		{
+			name:                 "Invalid minutes",
+			inputBody:            `{"started_at":"2025-01-01T10:00:00Z","minutes":2000}`,
+			mockBehaviour:        func(s *mockService.MockTimeEntry, input service.CreateTimeEntryInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"minutes":"must be at most 1440"}}}`,
+		},
+		{
+			name:                 "Missing start",
+			inputBody:            `{"minutes":30}`,
+			mockBehaviour:        func(s *mockService.MockTimeEntry, input service.CreateTimeEntryInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"started_at":"is required"}}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			timeEntry := mockService.NewMockTimeEntry(c)
+			testCase.mockBehaviour(timeEntry, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{TimeEntries: timeEntry}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/time-entries", handler.UserIdentityMiddleware, handler.CreateTimeEntry)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/time-entries", bytes.NewBufferString(testCase.inputBody))
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetTimeReport(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTimeEntry, input service.TimeReportInput)
+
+	testTable := []struct {
+		name                 string
+		query                string
+		input                service.TimeReportInput
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Ok",
+			query: "?dateFrom=2025-01-01&dateTo=2025-01-07&timezone=Europe/Berlin",
+			input: service.TimeReportInput{
+				UserID: testUserID, DateFrom: "2025-01-01", DateTo: "2025-01-07", Timezone: "Europe/Berlin",
+			},
+			mockBehaviour: func(s *mockService.MockTimeEntry, input service.TimeReportInput) {
+				s.EXPECT().GetReport(gomock.Any(), input).Return(domain.TimeReport{
+					Categories: []domain.CategoryTime{{CategoryID: testCategoryID, Title: "Work", Seconds: 9000}},
+					Days: []domain.DayTime{
+						{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Seconds: 3600},
+						{Date: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), Seconds: 5400},
+					},
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"date_from":"2025-01-01","date_to":"2025-01-07","timezone":"Europe/Berlin","total_minutes":150,` +
+				`"categories":[{"category_id":"` + testCategoryID + `","title":"Work","minutes":150}],` +
+				`"days":[{"date":"2025-01-01","minutes":60},{"date":"2025-01-03","minutes":90}]}`,
+		},
+		{
+			name:  "Empty report",
+			query: "?dateFrom=2025-01-01&dateTo=2025-01-01",
+			input: service.TimeReportInput{UserID: testUserID, DateFrom: "2025-01-01", DateTo: "2025-01-01"},
+			mockBehaviour: func(s *mockService.MockTimeEntry, input service.TimeReportInput) {
+				s.EXPECT().GetReport(gomock.Any(), input).Return(domain.TimeReport{}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"date_from":"2025-01-01","date_to":"2025-01-01","timezone":"UTC","total_minutes":0,` +
+				`"categories":[],"days":[]}`,
+		},
+		{
+			name:                 "Missing dates",
+			query:                "?dateTo=2025-01-07",
+			mockBehaviour:        func(s *mockService.MockTimeEntry, input service.TimeReportInput) {},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"dateFrom":"is required"}}}`,
+		},
+		{
+			name:  "Invalid range",
+			query: "?dateFrom=2025-01-07&dateTo=2025-01-01",
+			input: service.TimeReportInput{UserID: testUserID, DateFrom: "2025-01-07", DateTo: "2025-01-01"},
+			mockBehaviour: func(s *mockService.MockTimeEntry, input service.TimeReportInput) {
+				s.EXPECT().GetReport(gomock.Any(), input).Return(domain.TimeReport{}, customErrors.ErrInvalidDateRange)
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"string","details":"date range end must not be before its start"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			timeEntry := mockService.NewMockTimeEntry(c)
+			testCase.mockBehaviour(timeEntry, testCase.input)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{TimeEntries: timeEntry}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/time-tracking/report", handler.UserIdentityMiddleware, handler.GetTimeReport)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/time-tracking/report"+testCase.query, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+func TestGetTrash(t *testing.T) {
+	type mockBehaviour func(tasks *mockService.MockTask, categories *mockService.MockCategory, query domain.PaginationQuery)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	category := domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red", DeletedAt: &deletedAt}
+
+	testTable := []struct {
+		name                 string
+		queryString          string
+		query                domain.PaginationQuery
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:  "Ok",
+			query: domain.PaginationQuery{Page: 1, Limit: 20},
+			mockBehaviour: func(tasks *mockService.MockTask, categories *mockService.MockCategory, query domain.PaginationQuery) {
+				tasks.EXPECT().GetDeletedList(gomock.Any(), testUserID, query).Return(service.TaskListResult{
+					Items: []service.TaskOutput{{
+						ID:        testTaskID,
+						CreatedAt: createdAt,
+						UpdatedAt: createdAt,
+						Category:  category,
+						Title:     "Task",
+						DeletedAt: &deletedAt,
+					}},
+					TotalItems: 1,
+					TotalPages: 1,
+				}, nil)
+				categories.EXPECT().GetDeletedList(gomock.Any(), testUserID, query).Return(service.CategoryListResult{
+					Items:      []domain.Category{category},
+					TotalItems: 1,
+					TotalPages: 1,
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"tasks":{"page":1,"limit":20,"total_pages":1,"total_items":1,"items":[` +
+				`{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red","deleted_at":"2025-01-02T10:00:00Z"},` +
+				`"title":"Task","description":"","completed":false,"status":"todo","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null,"deleted_at":"2025-01-02T10:00:00Z"}]},` +
+				`"categories":{"page":1,"limit":20,"total_pages":1,"total_items":1,"items":[` +
+				`{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red","deleted_at":"2025-01-02T10:00:00Z"}]}}`,
+		},
+		{
+			name:        "Empty page",
+			queryString: "?page=3&limit=10",
+			query:       domain.PaginationQuery{Page: 3, Limit: 10, Offset: 20},
+			mockBehaviour: func(tasks *mockService.MockTask, categories *mockService.MockCategory, query domain.PaginationQuery) {
+				tasks.EXPECT().GetDeletedList(gomock.Any(), testUserID, query).Return(service.TaskListResult{
+					Items:      []service.TaskOutput{},
+					TotalItems: 12,
+					TotalPages: 2,
+				}, nil)
+				categories.EXPECT().GetDeletedList(gomock.Any(), testUserID, query).Return(service.CategoryListResult{
+					Items: []domain.Category{},
+				}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `{"tasks":{"page":3,"limit":10,"total_pages":2,"total_items":12,"items":[]},` +
+				`"categories":{"page":3,"limit":10,"total_pages":0,"total_items":0,"items":[]}}`,
+		},
+		{
+			name:        "Cursor",
+			queryString: "?cursor=",
+			mockBehaviour: func(tasks *mockService.MockTask, categories *mockService.MockCategory, query domain.PaginationQuery) {
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"cursor":"is not supported for the trash, use page"}}}`,
+		},
+		{
+			name:        "Invalid limit",
+			queryString: "?limit=100",
+			mockBehaviour: func(tasks *mockService.MockTask, categories *mockService.MockCategory, query domain.PaginationQuery) {
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"error":{"type":"dict","details":{"limit":"must be at most 50"}}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			tasks := mockService.NewMockTask(c)
+			categories := mockService.NewMockCategory(c)
+			testCase.mockBehaviour(tasks, categories, testCase.query)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: tasks, Categories: categories}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.GET("api/v1/trash", handler.UserIdentityMiddleware, handler.GetTrash)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/api/v1/trash"+testCase.queryString, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
+
+func TestRestoreTask(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Not in trash",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Restore(gomock.Any(), testTaskID, testUserID).Return(service.TaskOutput{}, customErrors.ErrTaskNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"task not found"}}`,
+		},
+		{
+			name: "Category in trash",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Restore(gomock.Any(), testTaskID, testUserID).Return(service.TaskOutput{}, customErrors.ErrTaskCategoryDeleted)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"task category is in the trash, restore the category first"}}`,
+		},
+		{
+			name: "Title taken",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Restore(gomock.Any(), testTaskID, testUserID).Return(service.TaskOutput{}, customErrors.ErrTaskAlreadyExists)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"task with such title already exists"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/tasks/:id/restore", handler.UserIdentityMiddleware, handler.RestoreTask)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/tasks/"+testTaskID+"/restore", nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"todo_list_go/internal/domain"
+	apiV1 "todo_list_go/internal/handlers/v1"
+	"todo_list_go/internal/service"
+	mockService "todo_list_go/internal/service/mocks"
+	mockJwt "todo_list_go/pkg/auth/mocks"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const testUndoToken = "4b7e2c9a-5d1f-4a83-9e6b-0f2c8d3a7b51"
+
+func TestUndoTasks(t *testing.T) {
+	type mockBehaviour func(s *mockService.MockTask)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name                 string
+		mockBehaviour        mockBehaviour
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name: "Ok",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Undo(gomock.Any(), testUndoToken, testUserID).Return([]service.TaskOutput{{
+					ID:        testTaskID,
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					Category:  domain.Category{ID: testCategoryID, CreatedAt: createdAt, Title: "Work", Color: "red"},
+					Title:     "Task",
+					Status:    domain.TaskStatusTodo,
+					Tags:      []domain.Tag{},
+				}}, nil)
+			},
+			expectedStatusCode: 200,
+			expectedResponseBody: `[{"id":"` + testTaskID + `","created_at":"2025-01-01T10:00:00Z","updated_at":"2025-01-01T10:00:00Z",` +
+				`"category":{"id":"` + testCategoryID + `","created_at":"2025-01-01T10:00:00Z","title":"Work","description":"","color":"red"},` +
+				`"title":"Task","description":"","completed":false,"status":"todo","priority":"none","due_date":null,"due_time":null,"due_timezone":null,"due_at":null,` +
+				`"checklist":{"done":0,"total":0},"estimate_minutes":null,"tracked_minutes":0,"comments_count":0,"recurrence":null,"series_id":null,"tags":[],"blocked_by":[],"blocks":[],"attachments":[],"position":"","archived_at":null}]`,
+		},
+		{
+			name: "Token expired",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Undo(gomock.Any(), testUndoToken, testUserID).Return(nil, customErrors.ErrUndoTokenNotFound)
+			},
+			expectedStatusCode:   404,
+			expectedResponseBody: `{"error":{"type":"string","details":"undo token not found or expired"}}`,
+		},
+		{
+			name: "Tasks changed",
+			mockBehaviour: func(s *mockService.MockTask) {
+				s.EXPECT().Undo(gomock.Any(), testUndoToken, testUserID).Return(nil, customErrors.ErrUndoConflict)
+			},
+			expectedStatusCode:   409,
+			expectedResponseBody: `{"error":{"type":"string","details":"tasks have changed since the operation, it can't be undone"}}`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Init deps
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			task := mockService.NewMockTask(c)
+			testCase.mockBehaviour(task)
+
+			tokenManager := mockJwt.NewMockTokenManager(c)
+			tokenManager.EXPECT().ParseJWT("token").Return(testUserID, nil)
+
+			services := &service.Services{Tasks: task}
+			handler := apiV1.NewHandler(services, tokenManager)
+
+			// Init server
+			r := gin.New()
+			r.POST("api/v1/undo/:token", handler.UserIdentityMiddleware, handler.UndoTasks)
+
+			// Test request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/api/v1/undo/"+testUndoToken, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// Perform request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, w.Code)
+			assert.Equal(t, testCase.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package markdown
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"todo_list_go/pkg/markdown"
+)
+
+func TestToHTML(t *testing.T) {
+	testTable := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "Formatting",
+			source:   "# Plan\n\n**bold** and `code`",
+			expected: "<h1>Plan</h1>\n\n<p><strong>bold</strong> and <code>code</code></p>",
+		},
+		{
+			name:     "Raw HTML",
+			source:   "<script>alert(1)</script>\n\ntext <b onclick=\"steal()\">bold</b>",
+			expected: "<p>text bold</p>",
+		},
+		{
+			name:   "Links",
+			source: "[unsafe](javascript:void) [site](https://example.com)",
+			expected: `<p><tt>unsafe</tt> <a href="https://example.com" target="_blank" ` +
+				`rel="nofollow noreferrer noopener">site</a></p>`,
+		},
+		{
+			name:     "Images",
+			source:   "![unsafe](data:image/png;base64,AAAA) ![logo](https://example.com/logo.png)",
+			expected: `<p> <img src="https://example.com/logo.png" alt="logo" /></p>`,
+		},
+		{
+			name:     "Escaped text",
+			source:   "1 < 2 & 3",
+			expected: "<p>1 &lt; 2 &amp; 3</p>",
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, markdown.ToHTML(testCase.source))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+	"todo_list_go/internal/repository"
+	mockRepository "todo_list_go/internal/repository/mocks"
+	"todo_list_go/internal/service"
+	customErrors "todo_list_go/pkg/errors"
+)
+
+const (
+	testUserID    = "f0e4c2f7-6d5e-4b0a-9a2c-3b1d8e7f6a5b"
+	testTaskID    = "6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b"
+	testBlockerID = "0c4c4a3d-6f39-4d7a-a4a7-7d1c3f5b8e21"
+	testOtherID   = "9b2d7c1e-3f4a-4e5b-8c6d-1a2b3c4d5e6f"
+)
+
+func TestTaskServiceAddDependency(t *testing.T) {
+	testTable := []struct {
+		name          string
+		blockers      map[string][]string
+		expectCreate  bool
+		expectedError error
+	}{
+		{
+			name:         "Ok",
+			blockers:     map[string][]string{testTaskID: {testOtherID}},
+			expectCreate: true,
+		},
+		{
+			name:          "Direct cycle",
+			blockers:      map[string][]string{testBlockerID: {testTaskID}},
+			expectedError: customErrors.ErrTaskDependencyCycle,
+		},
+		{
+			name:          "Indirect cycle",
+			blockers:      map[string][]string{testBlockerID: {testOtherID}, testOtherID: {testTaskID}},
+			expectedError: customErrors.ErrTaskDependencyCycle,
+		},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			transactor := mockRepository.NewMockTransactor(c)
+			taskRepo := mockRepository.NewMockTaskRepository(c)
+			tagRepo := mockRepository.NewMockTagRepository(c)
+			dependencyRepo := mockRepository.NewMockTaskDependencyRepository(c)
+			attachmentRepo := mockRepository.NewMockAttachmentRepository(c)
+
+			taskRepo.EXPECT().GetByID(gomock.Any(), testTaskID, testUserID).
+				Return(repository.TaskOutput{ID: testTaskID}, nil).AnyTimes()
+			taskRepo.EXPECT().GetByID(gomock.Any(), testBlockerID, testUserID).
+				Return(repository.TaskOutput{ID: testBlockerID}, nil)
+
+			// the cycle check and the insert run under the lock in one transaction
+			transactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+			gomock.InOrder(
+				dependencyRepo.EXPECT().LockByUserID(gomock.Any(), testUserID).Return(nil),
+				taskRepo.EXPECT().GetByIDForUpdate(gomock.Any(), testTaskID, testUserID).
+					Return(repository.TaskOutput{ID: testTaskID}, nil),
+				dependencyRepo.EXPECT().GetBlockersByUserID(gomock.Any(), testUserID).Return(testCase.blockers, nil),
+			)
+			if testCase.expectCreate {
+				dependencyRepo.EXPECT().Create(gomock.Any(), testTaskID, testBlockerID).Return(nil)
+				tagRepo.EXPECT().GetListByTaskIDs(gomock.Any(), []string{testTaskID}).Return(nil, nil)
+				dependencyRepo.EXPECT().GetListByTaskIDs(gomock.Any(), []string{testTaskID}).Return(
+					map[string][]string{testTaskID: {testBlockerID}}, nil, nil,
+				)
+				attachmentRepo.EXPECT().GetListByTaskIDs(gomock.Any(), []string{testTaskID}).Return(nil, nil)
+			}
+
+			tasksService := service.NewTaskService(
+				transactor, taskRepo, nil, tagRepo, dependencyRepo, attachmentRepo, nil, nil, 0, time.Minute,
+			)
+			task, err := tasksService.AddDependency(context.Background(), testTaskID, testBlockerID, testUserID)
+
+			assert.Equal(t, testCase.expectedError, err)
+			if testCase.expectCreate {
+				assert.Equal(t, []string{testBlockerID}, task.BlockedBy)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"todo_list_go/pkg/storage"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	local, err := storage.NewLocalStorage(filepath.Join(dir, "attachments"))
+	assert.NoError(t, err)
+	ctx := context.Background()
+	key := "user/task/notes.txt"
+
+	err = local.Put(ctx, key, bytes.NewBufferString("meeting notes"), 13, "text/plain")
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "attachments", "user", "task", "notes.txt"))
+
+	content, err := local.Get(ctx, key)
+	if assert.NoError(t, err) {
+		body, err := io.ReadAll(content)
+		assert.NoError(t, err)
+		assert.Equal(t, "meeting notes", string(body))
+		assert.NoError(t, content.Close())
+	}
+
+	assert.NoError(t, local.Delete(ctx, key))
+	assert.NoFileExists(t, filepath.Join(dir, "attachments", "user", "task", "notes.txt"))
+
+	_, err = local.Get(ctx, key)
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	assert.ErrorIs(t, local.Delete(ctx, key), storage.ErrObjectNotFound)
+}
+
+func TestLocalStorageInvalidKey(t *testing.T) {
+	dir := t.TempDir()
+	local, err := storage.NewLocalStorage(filepath.Join(dir, "attachments"))
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	for _, key := range []string{"../outside.txt", "user/../../outside.txt", ""} {
+		err := local.Put(ctx, key, bytes.NewBufferString("content"), 7, "text/plain")
+		assert.EqualError(t, err, `invalid storage key "`+key+`"`)
+	}
+	_, err = os.Stat(filepath.Join(dir, "outside.txt"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
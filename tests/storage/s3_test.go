@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"todo_list_go/pkg/storage"
+)
+
+// fakeS3 keeps objects of a single bucket in memory and checks that requests are signed.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+		r.Header.Get("X-Amz-Date") == "" {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.EscapedPath()] = body
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.EscapedPath()]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Write(body)
+	case http.MethodDelete:
+		if _, ok := f.objects[r.URL.EscapedPath()]; !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		delete(f.objects, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3Storage(t *testing.T, handler http.Handler) *storage.S3Storage {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	s3, err := storage.NewS3Storage(server.URL, "us-east-1", "attachments", "access", "secret")
+	assert.NoError(t, err)
+
+	return s3
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := &fakeS3{objects: make(map[string][]byte)}
+	s3 := newTestS3Storage(t, fake)
+	ctx := context.Background()
+	key := "user/task/notes 1.txt"
+
+	err := s3.Put(ctx, key, bytes.NewBufferString("meeting notes"), 13, "text/plain")
+	assert.NoError(t, err)
+	assert.Contains(t, fake.objects, "/attachments/user/task/notes%201.txt")
+
+	content, err := s3.Get(ctx, key)
+	if assert.NoError(t, err) {
+		body, err := io.ReadAll(content)
+		assert.NoError(t, err)
+		assert.Equal(t, "meeting notes", string(body))
+		assert.NoError(t, content.Close())
+	}
+
+	assert.NoError(t, s3.Delete(ctx, key))
+	assert.Empty(t, fake.objects)
+
+	_, err = s3.Get(ctx, key)
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	assert.ErrorIs(t, s3.Delete(ctx, key), storage.ErrObjectNotFound)
+}
+
+func TestS3StorageError(t *testing.T) {
+	s3 := newTestS3Storage(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "InternalError", http.StatusInternalServerError)
+	}))
+	ctx := context.Background()
+
+	err := s3.Put(ctx, "key", bytes.NewBufferString("content"), 7, "text/plain")
+	assert.EqualError(t, err, "s3 request failed with status 500: InternalError")
+
+	_, err = s3.Get(ctx, "key")
+	assert.EqualError(t, err, "s3 request failed with status 500: InternalError")
+
+	err = s3.Delete(ctx, "key")
+	assert.EqualError(t, err, "s3 request failed with status 500: InternalError")
+}
+
+func TestNewS3Storage(t *testing.T) {
+	testTable := []struct {
+		name          string
+		endpoint      string
+		bucket        string
+		expectedError string
+	}{
+		{name: "Ok", endpoint: "http://localhost:9000", bucket: "attachments"},
+		{
+			name:          "Relative endpoint",
+			endpoint:      "localhost:9000",
+			bucket:        "attachments",
+			expectedError: `invalid s3 endpoint "localhost:9000"`,
+		},
+		{name: "Empty bucket", endpoint: "http://localhost:9000", expectedError: "empty s3 bucket"},
+	}
+
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := storage.NewS3Storage(testCase.endpoint, "us-east-1", testCase.bucket, "access", "secret")
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}